@@ -4,22 +4,60 @@ var (
 	dayDD    = `(30|31|0[1-9]|[1-2]\d|[1-9])`
 	monthMM  = `(1[012]|0[1-9])`
 	yearYY   = `(\d\d)`
-	yearYYYY = `(20\d\dк?г?)`
+	yearYYYY = `([1-9]\d\d\d)(?:[" "]?г(?:[.]|[" "]|$)|к?г?)`
 	hourHH   = `(0[0-9]|1[0-9]|2[0-3]|[0-9])`
+	hourHH24 = `(0[0-9]|1[0-9]|2[0-4]|[0-9])`
 	minuteMM = `(0[0-9]|[0-5][0-9])`
+	secondSS = `([0-5][0-9])`
 )
 
 var (
-	morning  = `утром|утро|утра|morning|a.m`
-	evening  = `вечером|вечер|вечера|evening|p.m`
+	morning  = alternation(ruForms("утр", neutHard), `morning|a.m`)
+	evening  = alternation(ruForms("вечер", mascHard[:5]), `evening|p.m`)
 	midnight = "полночь|ночью|midnight"
 	noon     = "днем|полдень|noon|midday"
 )
 
+type dayPart int
+
+const (
+	partUnknown dayPart = iota
+	partMorning
+	partNoon
+	partEvening
+	partMidnight
+)
+
+var dayPartLookup = makeLookup(map[int]string{
+	int(partMorning):  morning,
+	int(partNoon):     noon,
+	int(partEvening):  evening,
+	int(partMidnight): midnight,
+})
+
+func parseDayPart(s string) dayPart { return dayPart(dayPartLookup[s]) }
+
 var (
 	today              = `сегодня|today`
 	tomorrow           = `завтра|tomorrow`
 	afterTomorrow      = `послезавтра|after tomorrow|aftertomorrow`
 	afterAfterTomorrow = `послепослезавтра|after after tomorrow|afteraftertomorrow`
 	yesterday          = `вчера|yesterday`
+	nowWords           = `прямо сейчас|сейчас|right now|now`
 )
+
+var (
+	// days from today
+	relativeDayLookup = makeLookup(map[int]string{
+		0: today,
+		1: tomorrow,
+		2: afterTomorrow,
+		3: afterAfterTomorrow,
+	})
+	yesterdaySet = makeSet(yesterday)
+)
+
+func parseRelativeDay(s string) (int, bool) {
+	v, ok := relativeDayLookup[s]
+	return v, ok
+}
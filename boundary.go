@@ -0,0 +1,175 @@
+package dateparse
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// working hours used for the boundaries of a period
+const (
+	dayStartHour  = 9
+	dayMiddleHour = 12
+)
+
+const defaultSprintDays = 14
+
+// a monday the sprints are counted from when Opts.SprintStart is not set, so they stay put from week to week
+var defaultSprintStart = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+type boundaryUnit int
+
+const (
+	boundaryUnknown boundaryUnit = iota
+	boundaryDay
+	boundaryWeek
+	boundaryMonth
+	boundaryQuarter
+	boundaryYear
+	boundarySprint
+)
+
+var (
+	periodStart  = alternation(`начало|начала|началу|начале|началом|start|beginning`)
+	periodMiddle = alternation(`середина|середины|середине|середину|серединой|middle`)
+	periodEnd    = alternation(`конец|конца|концу|конце|концом|end|close`)
+	periodParts  = alternation(periodStart, periodMiddle, periodEnd)
+
+	boundaryUnits = alternation(`дня|day`, `недели|week`, `месяца|month`, `квартала|quarter`, `года|year`, `спринта|sprint`)
+	boundaryAbbrs = `eod|cob|eow|eom|eoq|eoy`
+
+	boundaryPrefix = `(?:(?:в|во|к|до|на|by|at|until|till|before)[" "])?(?:the[" "])?`
+)
+
+var (
+	periodPartLookup = makeLookup(map[int]string{
+		int(AnchorStart):  periodStart,
+		int(AnchorMiddle): periodMiddle,
+		int(AnchorEnd):    periodEnd,
+	})
+	boundaryUnitLookup = makeLookup(map[int]string{
+		int(boundaryDay):     `дня|day|eod|cob`,
+		int(boundaryWeek):    `недели|week|eow`,
+		int(boundaryMonth):   `месяца|month|eom`,
+		int(boundaryQuarter): `квартала|quarter|eoq`,
+		int(boundaryYear):    `года|year|eoy`,
+		int(boundarySprint):  `спринта|sprint`,
+	})
+)
+
+var (
+	boundaryRegex     = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])%s(%s)(?:[" "]of)?[" "](?:the[" "])?(?:(%s)[" "])?(%s)(?:[" "]|$|[.,])`, boundaryPrefix, periodParts, periodModifier, boundaryUnits))
+	boundaryAbbrRegex = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])%s(%s)(?:[" "]|$|[.,])`, boundaryPrefix, boundaryAbbrs))
+)
+
+// "конец дня" and "eod" are a time of day, the day itself comes from the rest of the text
+func dayBoundary(s string) string {
+	if m := boundaryRegex.FindStringSubmatch(s); m != nil && boundaryUnit(boundaryUnitLookup[m[3]]) == boundaryDay {
+		return m[0]
+	}
+	if m := boundaryAbbrRegex.FindStringSubmatch(s); m != nil && boundaryUnit(boundaryUnitLookup[m[1]]) == boundaryDay {
+		return m[0]
+	}
+	return ""
+}
+
+func parseDayBoundary(s string, opts Opts) (time.Time, string) {
+	anchor, st := AnchorEnd, ""
+	if m := boundaryRegex.FindStringSubmatch(s); m != nil {
+		anchor, st = Anchor(periodPartLookup[m[1]]), m[0]
+	} else {
+		st = boundaryAbbrRegex.FindString(s)
+	}
+	return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), boundaryHour(anchor, opts), 0, 0, opts), st
+}
+
+// "в конце следующей недели", "до конца месяца", "начало квартала", "eow"
+func calculateBoundary(m []string, opts Opts, partPosition int, modifierPosition int, unitPosition int) (time.Time, string) {
+	anchor := AnchorEnd
+	if partPosition > 0 {
+		anchor = Anchor(periodPartLookup[m[partPosition]])
+	}
+	shift := 0
+	if modifierPosition > 0 {
+		shift = periodShift(m[modifierPosition])
+	}
+	unit := boundaryUnit(boundaryUnitLookup[m[unitPosition]])
+	day := anchorDay(anchor, unit, shift, opts)
+	// "к концу недели" said on saturday and "в середине месяца" said on the 31st are about the next period
+	if shift == 0 && getDate(day.Year(), day.Month(), day.Day(), boundaryHour(anchor, opts), 0, 0, opts).Before(opts.Now) {
+		day = anchorDay(anchor, unit, 1, opts)
+	}
+	return getDate(day.Year(), day.Month(), day.Day(), boundaryHour(anchor, opts), 0, 0, opts), m[0]
+}
+
+// the working day at the anchor of the period
+func anchorDay(anchor Anchor, unit boundaryUnit, shift int, opts Opts) time.Time {
+	start, end := boundaryPeriod(unit, shift, opts)
+	switch anchor {
+	case AnchorStart:
+		return nextWorkday(start, 1, opts)
+	case AnchorMiddle:
+		return nextWorkday(start.AddDate(0, 0, daysBetween(start, end)/2), 1, opts)
+	}
+	return nextWorkday(end.AddDate(0, 0, -1), -1, opts)
+}
+
+// [start, end) of the period the current moment belongs to, shifted by the given number of periods
+func boundaryPeriod(unit boundaryUnit, shift int, opts Opts) (time.Time, time.Time) {
+	now := opts.Now
+	switch unit {
+	case boundaryWeek:
+		start := getDate(now.Year(), now.Month(), now.Day()-(int(now.Weekday())+6)%7+7*shift, 0, 0, 0, opts)
+		return start, start.AddDate(0, 0, 7)
+	case boundaryMonth:
+		start := getDate(now.Year(), now.Month()+time.Month(shift), 1, 0, 0, 0, opts)
+		return start, start.AddDate(0, 1, 0)
+	case boundaryQuarter:
+		start := getDate(now.Year(), now.Month()-(now.Month()-1)%3+time.Month(3*shift), 1, 0, 0, 0, opts)
+		return start, start.AddDate(0, 3, 0)
+	case boundaryYear:
+		start := getDate(now.Year()+shift, time.January, 1, 0, 0, 0, opts)
+		return start, start.AddDate(1, 0, 0)
+	case boundarySprint:
+		return sprintPeriod(shift, opts)
+	}
+	start := getDate(now.Year(), now.Month(), now.Day()+shift, 0, 0, 0, opts)
+	return start, start.AddDate(0, 0, 1)
+}
+
+// sprints follow each other from opts.SprintStart, by default from defaultSprintStart
+func sprintPeriod(shift int, opts Opts) (time.Time, time.Time) {
+	length := opts.SprintDays
+	if length <= 0 {
+		length = defaultSprintDays
+	}
+	first := opts.SprintStart
+	if first.IsZero() {
+		first = defaultSprintStart
+	}
+	first = getDate(first.Year(), first.Month(), first.Day(), 0, 0, 0, opts)
+	today := getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), 0, 0, 0, opts)
+	elapsed := daysBetween(first, today)
+	n := elapsed / length
+	if elapsed < 0 && elapsed%length != 0 {
+		n--
+	}
+	start := first.AddDate(0, 0, (n+shift)*length)
+	return start, start.AddDate(0, 0, length)
+}
+
+func daysBetween(from time.Time, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a) / (24 * time.Hour))
+}
+
+func boundaryHour(anchor Anchor, opts Opts) int {
+	switch anchor {
+	case AnchorStart:
+		return dayStartHour
+	case AnchorMiddle:
+		return dayMiddleHour
+	}
+	return opts.TodayEndHour
+}
@@ -0,0 +1,156 @@
+package dateparse
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+type dayKind int
+
+const (
+	dayUsual   dayKind = iota
+	dayHoliday         // a public holiday, has a name
+	dayOff             // a day off moved from a holiday or a weekend
+	dayWorking         // a weekend day that is worked
+	dayShort           // a working day one hour shorter, before a holiday
+)
+
+var dayKindLookup = map[string]dayKind{
+	"holiday": dayHoliday,
+	"off":     dayOff,
+	"work":    dayWorking,
+	"short":   dayShort,
+}
+
+type calendarDay struct {
+	kind dayKind
+	name string
+}
+
+// public holidays of the labour code, used for the years the calendar has no data for. The fallback
+// does not move a holiday falling on a weekend to the next working day as the labour code does,
+// those days off are only known from the yearly data.
+var russianHolidays = map[string]string{
+	"01-01": "Новогодние каникулы",
+	"01-02": "Новогодние каникулы",
+	"01-03": "Новогодние каникулы",
+	"01-04": "Новогодние каникулы",
+	"01-05": "Новогодние каникулы",
+	"01-06": "Новогодние каникулы",
+	"01-07": "Рождество Христово",
+	"01-08": "Новогодние каникулы",
+	"02-23": "День защитника Отечества",
+	"03-08": "Международный женский день",
+	"05-01": "Праздник Весны и Труда",
+	"05-09": "День Победы",
+	"06-12": "День России",
+	"11-04": "День народного единства",
+}
+
+// RussianCalendar is the russian production calendar: public holidays, the days off they were moved to
+// and the weekends worked instead. It works offline with the data shipped in the package.
+type RussianCalendar struct {
+	mu    sync.RWMutex
+	days  map[string]calendarDay // by "2006-01-02"
+	years map[int]bool
+}
+
+// NewRussianCalendar returns the calendar with the data shipped in the package, Load adds newer years.
+func NewRussianCalendar() *RussianCalendar {
+	c := &RussianCalendar{days: make(map[string]calendarDay), years: make(map[int]bool)}
+	if err := c.Load(strings.NewReader(russianCalendarData)); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Load reads a yearly calendar file, every year in it replaces the known one. It is safe to call
+// while the calendar is in use. A line is a date,
+// the kind of the day (holiday, off, work or short) and an optional holiday name:
+//
+//	2026-01-07 holiday Рождество Христово
+//	2026-01-09 off
+func (c *RussianCalendar) Load(r io.Reader) error {
+	days := make(map[string]calendarDay)
+	years := make(map[int]bool)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.SplitN(text, " ", 3)
+		if len(fields) < 2 {
+			return fmt.Errorf("dateparse: calendar line %d: want a date and a kind of the day", line)
+		}
+		date, err := time.Parse("2006-01-02", fields[0])
+		if err != nil {
+			return fmt.Errorf("dateparse: calendar line %d: %v", line, err)
+		}
+		kind, ok := dayKindLookup[fields[1]]
+		if !ok {
+			return fmt.Errorf("dateparse: calendar line %d: unknown kind of the day %q", line, fields[1])
+		}
+		day := calendarDay{kind: kind}
+		if len(fields) == 3 {
+			day.name = strings.TrimSpace(fields[2])
+		}
+		days[fields[0]] = day
+		years[date.Year()] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, day := range c.days {
+		if !years[forceInt(key[:4])] {
+			days[key] = day
+		}
+	}
+	for year := range c.years {
+		years[year] = true
+	}
+	c.days, c.years = days, years
+	return nil
+}
+
+func (c *RussianCalendar) day(t time.Time) calendarDay {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if !c.years[t.Year()] {
+		if name, ok := russianHolidays[t.Format("01-02")]; ok {
+			return calendarDay{kind: dayHoliday, name: name}
+		}
+		return calendarDay{}
+	}
+	return c.days[t.Format("2006-01-02")]
+}
+
+func (c *RussianCalendar) IsWorkday(day time.Time) bool {
+	switch c.day(day).kind {
+	case dayHoliday, dayOff:
+		return false
+	case dayWorking, dayShort:
+		return true
+	}
+	return WeekendCalendar.IsWorkday(day)
+}
+
+// IsShortDay tells a working day before a holiday, it is one hour shorter.
+func (c *RussianCalendar) IsShortDay(day time.Time) bool {
+	return c.day(day).kind == dayShort
+}
+
+// HolidayName is the name of the public holiday on day, empty on other days.
+func (c *RussianCalendar) HolidayName(day time.Time) string {
+	if d := c.day(day); d.kind == dayHoliday {
+		return d.name
+	}
+	return ""
+}
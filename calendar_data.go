@@ -0,0 +1,173 @@
+package dateparse
+
+// non-working, worked and shortened days by the decrees of the russian government,
+// the format is described at RussianCalendar.Load
+var russianCalendarData = `# 2020
+2020-01-01 holiday Новогодние каникулы
+2020-01-02 holiday Новогодние каникулы
+2020-01-03 holiday Новогодние каникулы
+2020-01-04 holiday Новогодние каникулы
+2020-01-05 holiday Новогодние каникулы
+2020-01-06 holiday Новогодние каникулы
+2020-01-07 holiday Рождество Христово
+2020-01-08 holiday Новогодние каникулы
+2020-02-23 holiday День защитника Отечества
+2020-02-24 off
+2020-03-08 holiday Международный женский день
+2020-03-09 off
+2020-04-30 short
+2020-05-01 holiday Праздник Весны и Труда
+2020-05-04 off
+2020-05-05 off
+2020-05-08 short
+2020-05-09 holiday День Победы
+2020-05-11 off
+2020-06-11 short
+2020-06-12 holiday День России
+2020-11-03 short
+2020-11-04 holiday День народного единства
+2020-12-31 short
+# 2021
+2021-01-01 holiday Новогодние каникулы
+2021-01-02 holiday Новогодние каникулы
+2021-01-03 holiday Новогодние каникулы
+2021-01-04 holiday Новогодние каникулы
+2021-01-05 holiday Новогодние каникулы
+2021-01-06 holiday Новогодние каникулы
+2021-01-07 holiday Рождество Христово
+2021-01-08 holiday Новогодние каникулы
+2021-02-20 short
+2021-02-22 off
+2021-02-23 holiday День защитника Отечества
+2021-03-08 holiday Международный женский день
+2021-04-30 short
+2021-05-01 holiday Праздник Весны и Труда
+2021-05-03 off
+2021-05-09 holiday День Победы
+2021-05-10 off
+2021-06-11 short
+2021-06-12 holiday День России
+2021-06-14 off
+2021-11-03 short
+2021-11-04 holiday День народного единства
+2021-11-05 off
+2021-12-31 off
+# 2022
+2022-01-01 holiday Новогодние каникулы
+2022-01-02 holiday Новогодние каникулы
+2022-01-03 holiday Новогодние каникулы
+2022-01-04 holiday Новогодние каникулы
+2022-01-05 holiday Новогодние каникулы
+2022-01-06 holiday Новогодние каникулы
+2022-01-07 holiday Рождество Христово
+2022-01-08 holiday Новогодние каникулы
+2022-02-22 short
+2022-02-23 holiday День защитника Отечества
+2022-03-05 short
+2022-03-07 off
+2022-03-08 holiday Международный женский день
+2022-05-01 holiday Праздник Весны и Труда
+2022-05-02 off
+2022-05-03 off
+2022-05-09 holiday День Победы
+2022-05-10 off
+2022-06-12 holiday День России
+2022-06-13 off
+2022-11-03 short
+2022-11-04 holiday День народного единства
+# 2023
+2023-01-01 holiday Новогодние каникулы
+2023-01-02 holiday Новогодние каникулы
+2023-01-03 holiday Новогодние каникулы
+2023-01-04 holiday Новогодние каникулы
+2023-01-05 holiday Новогодние каникулы
+2023-01-06 holiday Новогодние каникулы
+2023-01-07 holiday Рождество Христово
+2023-01-08 holiday Новогодние каникулы
+2023-02-22 short
+2023-02-23 holiday День защитника Отечества
+2023-02-24 off
+2023-03-07 short
+2023-03-08 holiday Международный женский день
+2023-05-01 holiday Праздник Весны и Труда
+2023-05-08 off
+2023-05-09 holiday День Победы
+2023-06-12 holiday День России
+2023-11-03 short
+2023-11-04 holiday День народного единства
+2023-11-06 off
+# 2024
+2024-01-01 holiday Новогодние каникулы
+2024-01-02 holiday Новогодние каникулы
+2024-01-03 holiday Новогодние каникулы
+2024-01-04 holiday Новогодние каникулы
+2024-01-05 holiday Новогодние каникулы
+2024-01-06 holiday Новогодние каникулы
+2024-01-07 holiday Рождество Христово
+2024-01-08 holiday Новогодние каникулы
+2024-02-22 short
+2024-02-23 holiday День защитника Отечества
+2024-03-07 short
+2024-03-08 holiday Международный женский день
+2024-04-27 work
+2024-04-29 off
+2024-04-30 off
+2024-05-01 holiday Праздник Весны и Труда
+2024-05-08 short
+2024-05-09 holiday День Победы
+2024-05-10 off
+2024-06-11 short
+2024-06-12 holiday День России
+2024-11-02 short
+2024-11-04 holiday День народного единства
+2024-12-28 work
+2024-12-30 off
+2024-12-31 off
+# 2025
+2025-01-01 holiday Новогодние каникулы
+2025-01-02 holiday Новогодние каникулы
+2025-01-03 holiday Новогодние каникулы
+2025-01-04 holiday Новогодние каникулы
+2025-01-05 holiday Новогодние каникулы
+2025-01-06 holiday Новогодние каникулы
+2025-01-07 holiday Рождество Христово
+2025-01-08 holiday Новогодние каникулы
+2025-02-23 holiday День защитника Отечества
+2025-03-07 short
+2025-03-08 holiday Международный женский день
+2025-04-30 short
+2025-05-01 holiday Праздник Весны и Труда
+2025-05-02 off
+2025-05-08 off
+2025-05-09 holiday День Победы
+2025-06-11 short
+2025-06-12 holiday День России
+2025-06-13 off
+2025-11-01 short
+2025-11-03 off
+2025-11-04 holiday День народного единства
+2025-12-31 off
+# 2026
+2026-01-01 holiday Новогодние каникулы
+2026-01-02 holiday Новогодние каникулы
+2026-01-03 holiday Новогодние каникулы
+2026-01-04 holiday Новогодние каникулы
+2026-01-05 holiday Новогодние каникулы
+2026-01-06 holiday Новогодние каникулы
+2026-01-07 holiday Рождество Христово
+2026-01-08 holiday Новогодние каникулы
+2026-01-09 off
+2026-02-23 holiday День защитника Отечества
+2026-03-08 holiday Международный женский день
+2026-03-09 off
+2026-04-30 short
+2026-05-01 holiday Праздник Весны и Труда
+2026-05-08 short
+2026-05-09 holiday День Победы
+2026-05-11 off
+2026-06-11 short
+2026-06-12 holiday День России
+2026-11-03 short
+2026-11-04 holiday День народного единства
+2026-12-31 off
+`
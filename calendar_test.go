@@ -0,0 +1,105 @@
+package dateparse
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRussianCalendar(t *testing.T) {
+	c := NewRussianCalendar()
+	day := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	for _, tt := range []struct {
+		day     string
+		workday bool
+		short   bool
+		holiday string
+	}{
+		{"2021-02-20", true, true, ""},
+		{"2021-02-22", false, false, ""},
+		{"2024-04-27", true, false, ""},
+		{"2024-04-29", false, false, ""},
+		{"2025-02-24", true, false, ""},
+		{"2025-02-23", false, false, "День защитника Отечества"},
+		{"2026-01-07", false, false, "Рождество Христово"},
+		{"2026-01-09", false, false, ""},
+		{"2026-11-03", true, true, ""},
+		{"2026-10-19", true, false, ""},
+		{"2026-10-18", false, false, ""},
+		// no data, only the labour code holidays
+		{"2031-05-09", false, false, "День Победы"},
+		{"2031-05-12", true, false, ""},
+	} {
+		t.Run(tt.day, func(t *testing.T) {
+			d := day(tt.day)
+			if got := c.IsWorkday(d); got != tt.workday {
+				t.Errorf("workday: got %v want %v", got, tt.workday)
+			}
+			if got := c.IsShortDay(d); got != tt.short {
+				t.Errorf("short: got %v want %v", got, tt.short)
+			}
+			if got := c.HolidayName(d); got != tt.holiday {
+				t.Errorf("holiday: got '%s' want '%s'", got, tt.holiday)
+			}
+		})
+	}
+
+	t.Run("load", func(t *testing.T) {
+		c := NewRussianCalendar()
+		if err := c.Load(strings.NewReader("# 2031\n2031-05-09 holiday День Победы\n2031-05-12 off\n")); err != nil {
+			t.Fatal(err)
+		}
+		if c.IsWorkday(day("2031-05-12")) {
+			t.Error("2031-05-12 must be off")
+		}
+		if c.HolidayName(day("2031-01-01")) != "" {
+			t.Error("a loaded year replaces the labour code holidays")
+		}
+		if c.IsWorkday(day("2026-01-09")) {
+			t.Error("other years must stay")
+		}
+		for _, bad := range []string{"2031-05-12", "2031-13-01 off", "2031-05-12 weekend"} {
+			if err := c.Load(strings.NewReader(bad)); err == nil {
+				t.Errorf("'%s': want an error", bad)
+			}
+		}
+	})
+
+	t.Run("concurrent load", func(t *testing.T) {
+		c := NewRussianCalendar()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 100; i++ {
+				c.IsWorkday(day("2031-05-12"))
+			}
+		}()
+		for i := 0; i < 100; i++ {
+			if err := c.Load(strings.NewReader("2031-05-12 off\n")); err != nil {
+				t.Fatal(err)
+			}
+		}
+		<-done
+		if c.IsWorkday(day("2031-05-12")) {
+			t.Error("2031-05-12 must be off")
+		}
+	})
+
+	t.Run("parse", func(t *testing.T) {
+		loc, err := time.LoadLocation("Europe/Moscow")
+		if err != nil {
+			t.Fatal(err)
+		}
+		opts := &Opts{Now: time.Date(2025, 12, 30, 12, 1, 0, 0, loc), Calendar: c}
+		date, _ := Parse("через 3 рабочих дня", opts)
+		if want := time.Date(2026, 1, 14, 12, 1, 0, 0, loc); !date.Equal(want) {
+			t.Errorf("got %s want %s", date, want)
+		}
+	})
+}
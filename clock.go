@@ -0,0 +1,91 @@
+package dateparse
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// "полдевятого", "без четверти три", "двадцать минут пятого", "half past six", "quarter to ten"
+var (
+	hourGenitive = makeHourGenitive()
+	clockMinutes = fmt.Sprintf(`\d\d?|%s|%s`, alternation(quarter, half), cardinal)
+	clockHour    = fmt.Sprintf(`\d\d?|%s`, hourWords)
+	// "10 to 12" is rather a range, bare digits need the minutes word
+	enToMinutes   = fmt.Sprintf(`\d\d?[" "]minutes?|(?:%s|%s)(?:[" "]minutes?)?`, alternation(quarter, half), cardinal)
+	clockPrefix   = `(?:(?:в|к|at|by)[" "])?`
+	clockSuffixes = fmt.Sprintf(`(?:[" "]%s)?`, clockSuffix)
+)
+
+var (
+	ruHalfRegex        = regexp.MustCompile(fmt.Sprintf(`%s(?:пол|половин[аеуы][" "])[" "]?(%s)%s`, clockPrefix, hourGenitive, clockSuffixes))
+	ruQuarterRegex     = regexp.MustCompile(fmt.Sprintf(`%sчетверть[" "](%s)%s`, clockPrefix, hourGenitive, clockSuffixes))
+	ruMinutesPastRegex = regexp.MustCompile(fmt.Sprintf(`%s(%s)[" "]минут[ауы]?[" "](%s)%s`, clockPrefix, clockMinutes, hourGenitive, clockSuffixes))
+	ruWithoutRegex     = regexp.MustCompile(fmt.Sprintf(`%sбез[" "](%s)(?:[" "]минут[ы]?)?[" "](%s)%s`, clockPrefix, clockMinutes, clockHour, clockSuffixes))
+	enPastRegex        = regexp.MustCompile(fmt.Sprintf(`%s(?:a[" "])?(%s)(?:[" "]minutes?)?[" "](?:past|after)[" "](%s)\b%s`, clockPrefix, clockMinutes, clockHour, clockSuffixes))
+	enToRegex          = regexp.MustCompile(fmt.Sprintf(`(from[" "]|between[" "])?%s(?:a[" "])?(%s)[" "](?:to|till|before)[" "](%s)\b%s`, clockPrefix, enToMinutes, clockHour, clockSuffixes))
+)
+
+func makeHourGenitive() string {
+	var forms []string
+	for hour := 1; hour <= 12; hour++ {
+		for _, w := range splitAlternation(ruOrdinals[hour]) {
+			if strings.HasSuffix(w, "ого") || strings.HasSuffix(w, "его") {
+				forms = append(forms, w)
+			}
+		}
+	}
+	return strings.Join(forms, "|")
+}
+
+func parseClockPhrase(s string, opts Opts) (time.Time, string, bool) {
+	switch {
+	case ruHalfRegex.MatchString(s):
+		m := ruHalfRegex.FindStringSubmatch(s)
+		return clockPhraseTime(ordinalLookup[m[1]]-1, 30, m[2], opts), m[0], true
+	case ruQuarterRegex.MatchString(s):
+		m := ruQuarterRegex.FindStringSubmatch(s)
+		return clockPhraseTime(ordinalLookup[m[1]]-1, 15, m[2], opts), m[0], true
+	case ruMinutesPastRegex.MatchString(s):
+		m := ruMinutesPastRegex.FindStringSubmatch(s)
+		return clockPhraseTime(ordinalLookup[m[2]]-1, clockPhraseMinutes(m[1]), m[3], opts), m[0], true
+	case ruWithoutRegex.MatchString(s):
+		m := ruWithoutRegex.FindStringSubmatch(s)
+		return clockPhraseTime(forceNumber(m[2])-1, 60-clockPhraseMinutes(m[1]), m[3], opts), m[0], true
+	case enPastRegex.MatchString(s):
+		m := enPastRegex.FindStringSubmatch(s)
+		return clockPhraseTime(forceNumber(m[2]), clockPhraseMinutes(m[1]), m[3], opts), m[0], true
+	case enToRegex.MatchString(s):
+		// "from five to ten" is a range, not a clock phrase
+		if m := enToRegex.FindStringSubmatch(s); m[1] == "" {
+			return clockPhraseTime(forceNumber(m[3])-1, 60-clockPhraseMinutes(m[2]), m[4], opts), m[0], true
+		}
+	}
+	return opts.Now, "", false
+}
+
+// quarter and half are fractions of an hour
+func clockPhraseMinutes(s string) int {
+	s = strings.TrimSuffix(strings.TrimSuffix(s, " minutes"), " minute")
+	v := checkWordNumber(s)
+	if v < 1 {
+		return int(v * 60)
+	}
+	return int(v)
+}
+
+func clockPhraseTime(hour int, minute int, suffix string, opts Opts) time.Time {
+	if hour <= 0 {
+		hour += 12
+	}
+	if suffix != "" {
+		hour = applyMeridiem(hour, suffix)
+	}
+	date := getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), hour%24, minute, 0, opts)
+	// without a day part the nearest upcoming half of the day is meant
+	if suffix == "" && hour < 12 && date.Before(opts.Now) && date.Add(12*time.Hour).After(opts.Now) {
+		date = date.Add(12 * time.Hour)
+	}
+	return date
+}
@@ -3,33 +3,35 @@ package dateparse
 import (
 	"fmt"
 	"regexp"
-	"strings"
 	"time"
 )
 
 var (
 	datePrefix = `(в|во|in|on|ровно|the|at)`
 	dateSuffix = `(-ого|-го|-ва|-его|th|числа|date|\\|/|года|years|[.])`
-	daySuffix  = `(-ого|-го|-ва|-его|th|числа|date|\\)`
+	daySuffix  = `(-ого|-го|-ва|-его|-ое|-е|st|nd|rd|th|числа|date|\\)`
+
+	dayMonthSuffix = `(-ого|-го|-ва|-его|-ое|-е|st|nd|rd|th|числа|date|\\|/|года|years|[.])`
 )
 
 var (
 	baseDurRegex            = regexp.MustCompile(fmt.Sprintf(`(%s)[" "/]`, duration))
 	baseDurOnlyRegex        = regexp.MustCompile(fmt.Sprintf(`(%s)$`, duration))
-	baseDurTimeRegex        = regexp.MustCompile(fmt.Sprintf(`(\d\d?\d?)[" "](%s)`, durationTime))
+	baseDurTimeRegex        = regexp.MustCompile(fmt.Sprintf(`(\d\d?\d?|%s)[" "](%s)`, strictWordNumbers, durationTime))
 	baseWeekOnlyRegex       = regexp.MustCompile(fmt.Sprintf(`^(%s|%s)$`, weeks, shortWeeks))
 	baseWeekPrefixOnlyRegex = regexp.MustCompile(fmt.Sprintf(`^%s[" "](%s|%s)$`, datePrefix, weeks, shortWeeks))
 	baseWeekPrefixRegex     = regexp.MustCompile(fmt.Sprintf(`^%s[" "](%s|%s)[" "]`, datePrefix, weeks, shortWeeks))
 	baseWeekRegex           = regexp.MustCompile(fmt.Sprintf(`^(%s|%s)[" "]`, weeks, shortWeeks))
 	weekDurSuffixRegex      = regexp.MustCompile(fmt.Sprintf(`%s[" "](%s)[" "]%s`, datePrefix, weeks, durationSuffix))
 	durSuffixWeekRegex      = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?%s[" "]%s[" "](%s)`, datePrefix, durationSuffix, datePrefix, weeks))
-	durPrefixWeekRegex      = regexp.MustCompile(fmt.Sprintf(`%s[" "]%s[" "](%s)[" "]?%s?`, datePrefix, durPrefix, weeks, durationSuffix))
+	durPrefixWeekRegex      = regexp.MustCompile(fmt.Sprintf(`%s[" "](через|in|%s|%s|%s)[" "](%s)[" "]?%s?`, datePrefix, nextWords, lastWords, thisWords, weeks, durationSuffix))
+	enWeekModifierRegex     = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(next|last|previous|this)[" "](%s)\b`, latinWords(weeks)))
 )
 
 var (
-	ddRegex          = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?%s[" "]?%s`, datePrefix, dayDD, daySuffix))
+	ddRegex          = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?(?:the[" "])?%s[" "]?%s`, datePrefix, dayDD, daySuffix))
 	ddmmRegex        = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?%s[/.]%s\s?%s?`, datePrefix, dayDD, monthMM, dateSuffix))
-	ddMonthRegex     = regexp.MustCompile(fmt.Sprintf(`%s%s?[" "](%s)`, dayDD, dateSuffix, months))
+	ddMonthRegex     = regexp.MustCompile(fmt.Sprintf(`%s%s?[" "](%s)`, dayDD, dayMonthSuffix, months))
 	ddmmyyyyRegex    = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?%s[/.]%s[/.]%s\s?%s?`, datePrefix, dayDD, monthMM, yearYYYY, dateSuffix))
 	ddMonthyyyyRegex = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?%s[" "/.](%s)[" "/.]%s\s?%s?`, datePrefix, dayDD, months, yearYYYY, dateSuffix))
 	ddmmyyRegex      = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?%s[/.]%s[/.]%s\s?%s?`, datePrefix, dayDD, monthMM, yearYY, dateSuffix))
@@ -38,11 +40,32 @@ var (
 	isoyymmddRegex   = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?%s[/.-]?%s[/.-]?%s`, datePrefix, yearYY, monthMM, dayDD))
 )
 
+var (
+	monthddRegex     = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?(?:(?:%s|%s),?[" "])?(%s)[.]?[" "]%s(?:st|nd|rd|th)?\b`, datePrefix, latinWords(weeks), latinWords(shortWeeks), enMonths, dayDD))
+	monthddyyyyRegex = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?(?:(?:%s|%s),?[" "])?(%s)[.]?[" "]%s(?:st|nd|rd|th)?,?[" "]%s\b`, datePrefix, latinWords(weeks), latinWords(shortWeeks), enMonths, dayDD, yearYYYY))
+)
+
+var (
+	ordinalMonthRegex  = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?(?:the[" "])?(%s)[" "](?:of[" "])?(%s)`, datePrefix, ordinalDays, months))
+	monthOrdinalRegex  = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(%s)[" "](?:the[" "])?(%s)(?:[" "]|$|[.,])`, months, ordinalDays))
+	ordinalDayRegex    = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?(%s)[" "](?:числа|date)`, datePrefix, ordinalDays))
+	theOrdinalDayRegex = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])on[" "]the[" "](%s)(?:[" "]|$|[.,])`, ordinalDays))
+)
+
+// "сейчас" is read only when nothing else in the text is a date
+var nowRegex = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(?:%s)(?:[" "]|$|[.,!?])`, nowWords))
+
+var (
+	compoundDurRegex = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?%s[" "](%s(?:%s%s)*)(?:[" "]|$|[.,])`, datePrefix, durPrefix, durationPair, durationPairSep, durationPair))
+	agoDurRegex      = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(%s(?:%s%s)*)[" "](?:назад|ago)(?:[" "]|$|[.,])`, durationPair, durationPairSep, durationPair))
+	compactDurRegex  = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(?:(%s|в|во|к|с|at|by)[" "])?((?:\d+(?:[.,]\d+)?(?:%s)[" "]?)+)(?:[" "]|$|[.,])`, durPrefix, compactUnits))
+)
+
 var (
 	durTimeRegex   = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?%s[" "](\d\d?\d?)[" "]?(%s)?`, datePrefix, durPrefix, durationTime))
 	durRegex       = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?%s[" "](%s)?\s?(%s)`, datePrefix, durPrefix, wordNumbers, durationWds))
-	wdsRegex       = regexp.MustCompile(fmt.Sprintf(`(%s)\b[" "/]?%s?`, durationWds, durationSuffix))
-	wdsSuffuxRegex = regexp.MustCompile(fmt.Sprintf(`(%s)[" "/]%s[" "]%s`, durationWds, datePrefix, durationSuffix))
+	wdsRegex       = regexp.MustCompile(fmt.Sprintf(`(%s)\b[" "/]?%s?`, duration, durationSuffix))
+	wdsSuffuxRegex = regexp.MustCompile(fmt.Sprintf(`(%s)[" "/]%s[" "]%s`, duration, datePrefix, durationSuffix))
 	wdsTimeRegex   = regexp.MustCompile(fmt.Sprintf(`%s[" "](\d\d)[" "](%s)`, datePrefix, hours))
 )
 
@@ -60,6 +83,36 @@ func parseDate(s string, opts Opts) (t time.Time, st string) {
 		return calculateWeekDuration(baseWeekPrefixOnlyRegex.FindStringSubmatch(s), opts, 2)
 	case baseWeekOnlyRegex.MatchString(s):
 		return calculateWeekDuration(baseWeekOnlyRegex.FindStringSubmatch(s), opts, 1)
+	case enYearRegex.MatchString(s):
+		return calculateYear(enYearRegex.FindStringSubmatch(s), opts, 1)
+	case dayBoundary(s) != "":
+		return parseDate(cutMatch(s, dayBoundary(s)), opts)
+	case boundaryRegex.MatchString(s):
+		return calculateBoundary(boundaryRegex.FindStringSubmatch(s), opts, 1, 2, 3)
+	case boundaryAbbrRegex.MatchString(s):
+		return calculateBoundary(boundaryAbbrRegex.FindStringSubmatch(s), opts, 0, 0, 1)
+	case workdayDurRegex.MatchString(s):
+		return calculateWorkdayDuration(workdayDurRegex.FindStringSubmatch(s), opts, 2, 1)
+	case workdayAgoRegex.MatchString(s):
+		return calculateWorkdayDuration(workdayAgoRegex.FindStringSubmatch(s), opts, 1, -1)
+	case nextWorkdayRegex.MatchString(s):
+		return calculateNextWorkday(nextWorkdayRegex.FindStringSubmatch(s), opts)
+	case hasHoliday(s):
+		return calculateHoliday(holidayRegex.FindStringSubmatch(s), opts)
+	case weekendRegex.MatchString(s):
+		return calculateWeekend(weekendRegex.FindStringSubmatch(s), opts)
+	case relativePeriodRegex.MatchString(s):
+		return calculateRelativePeriod(relativePeriodRegex.FindStringSubmatch(s), opts)
+	case hasMonthPeriod(s):
+		return calculateMonthPeriod(monthPeriodRegex.FindStringSubmatch(s), opts)
+	case isoMonthRegex.MatchString(s):
+		return calculateIsoMonth(isoMonthRegex.FindStringSubmatch(s), opts)
+	case hasSeasonPeriod(s):
+		return calculateSeasonPeriod(seasonRegex.FindStringSubmatch(s), opts)
+	case monthddyyyyRegex.MatchString(s):
+		return calculateFullDate(monthddyyyyRegex.FindStringSubmatch(s), opts, 4, 2, 3)
+	case monthddRegex.MatchString(s):
+		return calculateDate(monthddRegex.FindStringSubmatch(s), opts, 2, 3)
 	case wdsSuffuxRegex.MatchString(s):
 		return calculateWordsDate(wdsSuffuxRegex.FindStringSubmatch(s), opts)
 	case baseDurRegex.MatchString(s):
@@ -70,6 +123,14 @@ func parseDate(s string, opts Opts) (t time.Time, st string) {
 		return calculateWeekDuration(baseWeekPrefixRegex.FindStringSubmatch(s), opts, 2)
 	case baseWeekRegex.MatchString(s):
 		return calculateWeekDuration(baseWeekRegex.FindStringSubmatch(s), opts, 1)
+	case enWeekModifierRegex.MatchString(s):
+		return calculateWeekDuration(enWeekModifierRegex.FindStringSubmatch(s), opts, 2)
+	case agoDurRegex.MatchString(s):
+		return calculateAgoDuration(agoDurRegex.FindStringSubmatch(s), opts)
+	case compoundDurRegex.MatchString(s):
+		return calculateCompoundDuration(compoundDurRegex.FindStringSubmatch(s), opts)
+	case hasCompactDuration(s):
+		return calculateCompactDuration(compactDurRegex.FindStringSubmatch(s), opts)
 	case durTimeRegex.MatchString(s):
 		return calculateDuration(durTimeRegex.FindStringSubmatch(s), opts, 2)
 	case durRegex.MatchString(s):
@@ -98,6 +159,16 @@ func parseDate(s string, opts Opts) (t time.Time, st string) {
 		return calculateFullDate(isoyyyymmddRegex.FindStringSubmatch(s), opts, 2, 3, 4)
 	case isoyymmddRegex.MatchString(s):
 		return calculateFullDate(isoyymmddRegex.FindStringSubmatch(s), opts, 2, 3, 4)
+	case yearRegex.MatchString(s):
+		return calculateYear(yearRegex.FindStringSubmatch(s), opts, 2)
+	case ordinalMonthRegex.MatchString(s):
+		return calculateDate(ordinalMonthRegex.FindStringSubmatch(s), opts, 3, 2)
+	case monthOrdinalRegex.MatchString(s):
+		return calculateDate(monthOrdinalRegex.FindStringSubmatch(s), opts, 1, 2)
+	case ordinalDayRegex.MatchString(s):
+		return calculateDay(ordinalDayRegex.FindStringSubmatch(s), opts, 2)
+	case theOrdinalDayRegex.MatchString(s):
+		return calculateDay(theOrdinalDayRegex.FindStringSubmatch(s), opts, 1)
 	case ddMonthRegex.MatchString(s):
 		return calculateDate(ddMonthRegex.FindStringSubmatch(s), opts, 3, 1)
 	case ddmmRegex.MatchString(s):
@@ -108,7 +179,7 @@ func parseDate(s string, opts Opts) (t time.Time, st string) {
 		m := wdsTimeRegex.FindStringSubmatch(s)
 		date := getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), forceInt(m[2]), 0, 0, opts)
 		if date.Before(opts.Now) {
-			date = date.Add(24 * time.Hour)
+			date = date.AddDate(0, 0, 1)
 		}
 		return date, m[0]
 	case baseDurTimeRegex.MatchString(s):
@@ -116,9 +187,7 @@ func parseDate(s string, opts Opts) (t time.Time, st string) {
 	case wdsRegex.MatchString(s):
 		return calculateWordsDate(wdsRegex.FindStringSubmatch(s), opts)
 	case ddRegex.MatchString(s):
-		m := ddRegex.FindStringSubmatch(s)
-		day := forceInt(m[2])
-		return getDate(opts.Now.Year(), opts.Now.Month(), day, opts.TodayEndHour, 0, 0, opts), m[0]
+		return calculateDay(ddRegex.FindStringSubmatch(s), opts, 2)
 	}
 	return opts.Now, st
 }
@@ -128,29 +197,30 @@ func getDate(year int, month time.Month, day int, hour int, minute int, second i
 }
 
 func calculateDate(m []string, opts Opts, monthPosition int, dayPosition int) (time.Time, string) {
-	month := opts.Now.Month()
-	if mth := parseMonth(m[monthPosition]); mth != 0 {
-		month = mth
-	} else {
+	month, ok := parseMonth(m[monthPosition])
+	if !ok {
 		month = time.Month(forceInt(m[monthPosition]))
 	}
 
-	year := opts.Now.Year()
+	day := parseDay(m[dayPosition])
+	return getDate(dateYear(month, opts), month, day, opts.TodayEndHour, 0, 0, opts), m[0]
+}
+
+// a date without a year is this year's one, or the next year's when its month has passed
+func dateYear(month time.Month, opts Opts) int {
 	if month < opts.Now.Month() {
-		year++
+		return opts.Now.Year() + 1
 	}
+	return opts.Now.Year()
+}
 
-	day := forceInt(m[dayPosition])
-	return getDate(year, month, day, opts.TodayEndHour, 0, 0, opts), m[0]
+func calculateDay(m []string, opts Opts, dayPosition int) (time.Time, string) {
+	day := parseDay(m[dayPosition])
+	return getDate(opts.Now.Year(), opts.Now.Month(), day, opts.TodayEndHour, 0, 0, opts), m[0]
 }
 
 func calculateFullDate(m []string, opts Opts, yearPosition int, monthPosition int, dayPosition int) (time.Time, string) {
-	year := opts.Now.Year()
-	if len(m[yearPosition]) == 2 {
-		year = forceInt("20" + m[yearPosition][:2])
-	} else {
-		year = forceInt(m[yearPosition][:4])
-	}
+	year := fullYear(m[yearPosition], opts)
 	date, _ := calculateDate(m, opts, monthPosition, dayPosition)
 	if date.Month() < opts.Now.Month() && year == opts.Now.Year() {
 		year += 1
@@ -161,41 +231,33 @@ func calculateFullDate(m []string, opts Opts, yearPosition int, monthPosition in
 func calculateWordsDate(m []string, opts Opts) (time.Time, string) {
 	m = normalizeStrings(m)
 	date := getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), opts.Now.Hour(), opts.Now.Minute(), 0, opts)
-	str := strings.Replace(m[0], "/", "", 1)
-	switch {
-	case strings.Contains(today, str) || strings.Contains(today, m[1]):
-		date = getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), opts.TodayEndHour, 0, 0, opts)
-	case strings.Contains(tomorrow, str) || strings.Contains(tomorrow, m[1]):
-		date = getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day()+1, opts.TodayEndHour, 0, 0, opts)
-	case strings.Contains(afterTomorrow, m[0]):
-		date = getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day()+2, opts.TodayEndHour, 0, 0, opts)
-	case strings.Contains(afterAfterTomorrow, m[0]):
-		date = getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day()+3, opts.TodayEndHour, 0, 0, opts)
-	case strings.Contains(yesterday, m[0]):
-		date = getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day()+365, opts.TodayEndHour, 0, 0, opts)
+	if days, ok := parseRelativeDay(m[1]); ok {
+		date = getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day()+days, opts.TodayEndHour, 0, 0, opts)
+	} else if yesterdaySet[m[1]] {
+		date = getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day()-1, opts.TodayEndHour, 0, 0, opts)
 	}
 	if len(m) > 2 {
-		switch {
-		case strings.Contains(morning, m[2]):
+		switch parseDayPart(m[2]) {
+		case partMorning:
 			date = getDate(date.Year(), date.Month(), date.Day(), 10, 0, 0, opts)
-		case strings.Contains(noon, m[2]):
+		case partNoon:
 			date = getDate(date.Year(), date.Month(), date.Day(), 12, 0, 0, opts)
-		case strings.Contains(evening, m[2]):
+		case partEvening:
 			date = getDate(date.Year(), date.Month(), date.Day(), 18, 0, 0, opts)
-		case strings.Contains(midnight, m[2]):
+		case partMidnight:
 			if date.Day() == opts.Now.Day() {
-				date = date.Add(24 * time.Hour)
+				date = date.AddDate(0, 0, 1)
 			}
 			date = getDate(date.Year(), date.Month(), date.Day(), 0, 0, 0, opts)
 		}
 	}
 	if len(m) > 3 {
-		switch {
-		case strings.Contains(noon, m[3]):
+		switch parseDayPart(m[3]) {
+		case partNoon:
 			date = getDate(date.Year(), date.Month(), date.Day(), 12, 0, 0, opts)
-		case strings.Contains(midnight, m[3]):
+		case partMidnight:
 			if date.Day() == opts.Now.Day() {
-				date = date.Add(24 * time.Hour)
+				date = date.AddDate(0, 0, 1)
 			}
 			date = getDate(date.Year(), date.Month(), date.Day(), 0, 0, 0, opts)
 		}
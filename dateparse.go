@@ -1,22 +1,114 @@
 package dateparse
 
 import (
+	"errors"
 	"strings"
 	"time"
 )
 
+var ErrNoDuration = errors.New("dateparse: no duration found")
+
 type Opts struct {
 	TodayEndHour int
 	Now          time.Time
+	YearPivot    int       // two-digit years below it are 20xx, the rest are 19xx; 69 by default
+	PeriodAnchor Anchor    // day a month, season or year resolves to
+	SprintStart  time.Time // first day of any sprint, monday 2024-01-01 by default
+	SprintDays   int       // 14 by default
+	Locale       Locale    // of Humanize and Normalize, russian by default
+
+	Calendar Calendar // working days, saturday and sunday are off by default
+	// where a date landing on a day off moves, it stays by default. Only the dates without a clock of their own
+	// move: "завтра" and "в пятницу" do, "завтра в 10" and "через 2 часа" are exact and stay, and so do
+	// "на выходных" and "по будням" that name the days themselves.
+	NonWorkingShift DayShift
+}
+
+type Granularity int
+
+const (
+	GranularityTime Granularity = iota
+	GranularityMonth
+	GranularitySeason
+	GranularityYear
+)
+
+type Result struct {
+	Date        time.Time
+	Message     string
+	Corrections []Correction
+	Location    *time.Location // time zone mentioned in the text, if any
+	Granularity Granularity
+	End         time.Time      // the last day of a weekend or weekdays phrase, at the time of Date
+	Weekdays    []time.Weekday // the days "по выходным" or "on weekdays" stands for
 }
 
 func Parse(s string, opts *Opts) (time.Time, string) {
+	res := ParseResult(s, opts)
+	return res.Date, res.Message
+}
+
+func ParseResult(s string, opts *Opts) Result {
+	res, _ := parse(s, opts)
+	return res
+}
+
+// also returns the pieces of the input the date was read from
+func parse(s string, opts *Opts) (Result, []span) {
 	if opts == nil {
 		opts = new(Opts)
 	}
 	if opts.TodayEndHour == 0 {
 		opts.TodayEndHour = 18
 	}
-	date, msg := dateTimeParse(strings.TrimSpace(strings.ToLower(s)), *opts)
-	return date.Round(time.Second), msg
+	in := newText(s).trimSpace()
+	if date, match, loc, ok := parseTimestamp(in.s, *opts); ok {
+		_, sp, _ := in.cut(match)
+		return Result{
+			Date:     date.In(opts.Now.Location()),
+			Message:  cutSpans(s, []span{sp}),
+			Location: loc,
+		}, []span{sp}
+	}
+	in, typos := correctTypos(in)
+	zone, loc := extractZone(in.s)
+	in, zoneSpan, hasZone := in.cut(zone)
+	parseOpts := *opts
+	if loc != nil {
+		parseOpts.Now = opts.Now.In(loc)
+	}
+	date, granularity, spans, clock := dateTimeParse(in, parseOpts)
+	if len(spans) == 0 {
+		if m := nowRegex.FindString(in.s); m != "" {
+			_, sp, _ := in.cut(m)
+			date, granularity, spans = parseOpts.Now, GranularityTime, []span{sp}
+		}
+	}
+	if date.IsZero() || len(spans) == 0 {
+		return Result{}, nil
+	}
+	if hasZone {
+		spans = append(spans, zoneSpan)
+	}
+	res := Result{
+		Date:        date.In(opts.Now.Location()).Round(time.Second),
+		Message:     cutSpans(s, spans),
+		Corrections: appliedTypos(typos, spans),
+		Location:    loc,
+		Granularity: granularity,
+		Weekdays:    weekdaysOf(spans),
+	}
+	if res.Weekdays != nil {
+		res.End = lastWeekday(res.Date, res.Weekdays)
+	}
+	if !clock && res.Weekdays == nil {
+		res.Date = shiftToWorkday(date, parseOpts).In(opts.Now.Location()).Round(time.Second)
+	}
+	return res, spans
+}
+
+// ParseDuration converts "полтора часа", "2 дня 3 часа", "45 min" or "1h30m" to a duration.
+// It also returns the consumed span of the text.
+func ParseDuration(s string) (time.Duration, string, error) {
+	return parseDurationSpan(strings.TrimSpace(strings.ToLower(s)))
 }
@@ -215,7 +215,7 @@ func TestDateparse(t *testing.T) {
 		},
 		"23 14:00 поужинать в кафе": {
 			time.Date(dt.Year(), dt.Month(), dt.Day(), 14, 0, 0, 0, dt.Location()),
-			"23  поужинать в кафе",
+			"23 поужинать в кафе",
 		},
 		"23-ого в 14:00 срезы": {
 			time.Date(dt.Year(), dt.Month(), 23, 14, 0, 0, 0, dt.Location()),
@@ -367,7 +367,7 @@ func TestDateparse(t *testing.T) {
 		},
 		"что завтра совещание": {
 			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 18, 0, 0, 0, dt.Location()),
-			"что  совещание",
+			"что совещание",
 		},
 		"в полночь": {
 			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 0, 0, 0, 0, dt.Location()),
@@ -390,7 +390,7 @@ func TestDateparse(t *testing.T) {
 			"",
 		},
 		"вчера": {
-			time.Date(dt.Year(), dt.Month(), dt.Day()+365, 18, 0, 0, 0, dt.Location()),
+			time.Date(dt.Year(), dt.Month(), dt.Day()-1, 18, 0, 0, 0, dt.Location()),
 			"",
 		},
 		"завтра в 12": {
@@ -609,6 +609,562 @@ func TestDateparse(t *testing.T) {
 			dt.Add(time.Minute * 30),
 			"тест",
 		},
+		"звтра": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"в понеделник убраться": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+2, 18, 0, 0, 0, dt.Location()),
+			"убраться",
+		},
+		"tommorow at midnight eat": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 0, 0, 0, 0, dt.Location()),
+			"eat",
+		},
+		"on wendesday test": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+4, 18, 0, 0, 0, dt.Location()),
+			"test",
+		},
+		"через 2 минтуы": {
+			dt.Add(2 * time.Minute),
+			"",
+		},
+		"завтра сварить завтрак": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 18, 0, 0, 0, dt.Location()),
+			"сварить завтрак",
+		},
+		"через секундочку тест": {
+			dt.Add(time.Second),
+			"тест",
+		},
+		"через полчасика": {
+			dt.Add(30 * time.Minute),
+			"",
+		},
+		"через 2 минутки": {
+			dt.Add(2 * time.Minute),
+			"",
+		},
+		"в прошлый понедельник": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()-5, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"в эту среду": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+4, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"в следующую пятницу": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+13, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"через двадцать пять минут": {
+			dt.Add(25 * time.Minute),
+			"",
+		},
+		"через одну неделю": {
+			dt.Add(7 * 24 * time.Hour),
+			"",
+		},
+		"через две недели отпуск": {
+			dt.Add(2 * 7 * 24 * time.Hour),
+			"отпуск",
+		},
+		"через полтора часа": {
+			dt.Add(90 * time.Minute),
+			"",
+		},
+		"через полторы недели": {
+			dt.Add(time.Duration(10.5 * float64(24*time.Hour))),
+			"",
+		},
+		"через пару часов": {
+			dt.Add(2 * time.Hour),
+			"",
+		},
+		"через сто двадцать секунд": {
+			dt.Add(2 * time.Minute),
+			"",
+		},
+		"twenty-one days": {
+			dt.Add(21 * 24 * time.Hour),
+			"",
+		},
+		"in a couple of hours": {
+			dt.Add(2 * time.Hour),
+			"",
+		},
+		"in a few minutes": {
+			dt.Add(3 * time.Minute),
+			"",
+		},
+		"in an hour call mom": {
+			dt.Add(time.Hour),
+			"call mom",
+		},
+		"в пять вечера": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 17, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"завтра в десять": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 10, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"первое марта": {
+			time.Date(dt.Year()+1, 3, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"до двадцать первого декабря сдать отчет": {
+			time.Date(dt.Year(), 12, 21, 18, 0, 0, 0, dt.Location()),
+			"до сдать отчет",
+		},
+		"двадцать пятого числа": {
+			time.Date(dt.Year(), dt.Month(), 25, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"третьего числа в 14:00 созвон": {
+			time.Date(dt.Year(), dt.Month(), 3, 14, 0, 0, 0, dt.Location()),
+			"созвон",
+		},
+		"march twenty-first": {
+			time.Date(dt.Year()+1, 3, 21, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"the first of may": {
+			time.Date(dt.Year()+1, 5, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"on the 2nd": {
+			time.Date(dt.Year(), dt.Month(), 2, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"on the second call bob": {
+			time.Date(dt.Year(), dt.Month(), 2, 18, 0, 0, 0, dt.Location()),
+			"call bob",
+		},
+		"1st november": {
+			time.Date(dt.Year(), 11, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"23-е": {
+			time.Date(dt.Year(), dt.Month(), 23, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"march 5": {
+			time.Date(dt.Year()+1, 3, 5, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"mar 5th, 2021 release": {
+			time.Date(2021, 3, 5, 18, 0, 0, 0, dt.Location()),
+			"release",
+		},
+		"dec 31, 2025": {
+			time.Date(2025, 12, 31, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"on jan 3 at 9am": {
+			time.Date(dt.Year()+1, 1, 3, 9, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"friday, march 5 demo": {
+			time.Date(dt.Year()+1, 3, 5, 18, 0, 0, 0, dt.Location()),
+			"demo",
+		},
+		"November 2nd": {
+			time.Date(dt.Year(), 11, 2, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"3:30pm standup": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 15, 30, 0, 0, dt.Location()),
+			"standup",
+		},
+		"at 7.15 a.m.": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 7, 15, 0, 0, dt.Location()),
+			"",
+		},
+		"12am": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 0, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"tomorrow 12pm": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 12, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"at 5 pm": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 17, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"lunch noon-ish": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 12, 0, 0, 0, dt.Location()),
+			"lunch",
+		},
+		"в 3:30 дня": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 15, 30, 0, 0, dt.Location()),
+			"",
+		},
+		"в 3 дня": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 15, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"в 7:45 вечера": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 19, 45, 0, 0, dt.Location()),
+			"",
+		},
+		"в 11 ночи": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 23, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"в 2 ночи": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 2, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"полдевятого": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 20, 30, 0, 0, dt.Location()),
+			"",
+		},
+		"в полдевятого утра кино": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 8, 30, 0, 0, dt.Location()),
+			"кино",
+		},
+		"без пятнадцати три": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 14, 45, 0, 0, dt.Location()),
+			"",
+		},
+		"завтра без четверти девять утра": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 8, 45, 0, 0, dt.Location()),
+			"",
+		},
+		"четверть пятого": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 16, 15, 0, 0, dt.Location()),
+			"",
+		},
+		"двадцать минут первого": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 12, 20, 0, 0, dt.Location()),
+			"",
+		},
+		"half past six": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 18, 30, 0, 0, dt.Location()),
+			"",
+		},
+		"at 20 minutes to 5 pm": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 16, 40, 0, 0, dt.Location()),
+			"",
+		},
+		"quarter to ten am": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 9, 45, 0, 0, dt.Location()),
+			"",
+		},
+		"at ten past 7 pm": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 19, 10, 0, 0, dt.Location()),
+			"",
+		},
+		"в 10ч": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 10, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"19h30 dinner": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 19, 30, 0, 0, dt.Location()),
+			"dinner",
+		},
+		"в 10-00 планерка": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 10, 0, 0, 0, dt.Location()),
+			"планерка",
+		},
+		"в 1930": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 19, 30, 0, 0, dt.Location()),
+			"",
+		},
+		"в 1930 ч": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 19, 30, 0, 0, dt.Location()),
+			"",
+		},
+		"в 0730": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 7, 30, 0, 0, dt.Location()),
+			"",
+		},
+		"meet at 1030": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 10, 30, 0, 0, dt.Location()),
+			"meet",
+		},
+		"at 0900 hrs": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 9, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"19:30:45": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 19, 30, 45, 0, dt.Location()),
+			"",
+		},
+		"завтра в 12:30:45": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 12, 30, 45, 0, dt.Location()),
+			"",
+		},
+		"в 24:00": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 0, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"завтра в 24:00": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+2, 0, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"созвон в 10 по мск": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 10, 0, 0, 0, dt.Location()),
+			"созвон",
+		},
+		"3pm pst": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 2, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"at 9:00 utc+2": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 10, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"завтра в 18 по лондону": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 20, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"call at 5pm europe/berlin": {
+			time.Date(dt.Year(), dt.Month(), dt.Day(), 18, 0, 0, 0, dt.Location()),
+			"call",
+		},
+		"demo tomorrow 10am new york time": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+1, 17, 0, 0, 0, dt.Location()),
+			"demo",
+		},
+		"2024-03-05T10:00:00+03:00": {
+			time.Date(2024, 3, 5, 10, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"Tue, 5 Mar 2024 10:00:00 +0300 письмо": {
+			time.Date(2024, 3, 5, 10, 0, 0, 0, dt.Location()),
+			"письмо",
+		},
+		"build @1709625600 passed": {
+			time.Date(2024, 3, 5, 11, 0, 0, 0, dt.Location()),
+			"build passed",
+		},
+		"12.04.1987": {
+			time.Date(1987, 4, 12, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"др 12 апреля 1987 г.": {
+			time.Date(1987, 4, 12, 18, 0, 0, 0, dt.Location()),
+			"др",
+		},
+		"01.03.2105": {
+			time.Date(2105, 3, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"12.04.87": {
+			time.Date(1987, 4, 12, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"12.04.24": {
+			time.Date(2024, 4, 12, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"архив в 1999 году": {
+			time.Date(1999, 1, 1, 18, 0, 0, 0, dt.Location()),
+			"архив",
+		},
+		"ремонт в 2027 году": {
+			time.Date(2027, 1, 1, 18, 0, 0, 0, dt.Location()),
+			"ремонт",
+		},
+		"move in 2027": {
+			time.Date(2027, 1, 1, 18, 0, 0, 0, dt.Location()),
+			"move",
+		},
+		"отпуск в марте": {
+			time.Date(2021, 3, 1, 18, 0, 0, 0, dt.Location()),
+			"отпуск",
+		},
+		"in october": {
+			time.Date(2020, 10, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"в мае 2022": {
+			time.Date(2022, 5, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"next october": {
+			time.Date(2021, 10, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"в прошлом июне": {
+			time.Date(2020, 6, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"в следующем месяце сдать отчёт": {
+			time.Date(2020, 11, 1, 18, 0, 0, 0, dt.Location()),
+			"сдать отчёт",
+		},
+		"next year": {
+			time.Date(2021, 1, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"в прошлом году": {
+			time.Date(2019, 1, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"летом": {
+			time.Date(2021, 6, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"this autumn": {
+			time.Date(2020, 9, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"следующей осенью": {
+			time.Date(2021, 9, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"съездить на дачу зимой": {
+			time.Date(2020, 12, 1, 18, 0, 0, 0, dt.Location()),
+			"съездить на дачу",
+		},
+		"прошлой зимой": {
+			time.Date(2019, 12, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"in may 5th": {
+			time.Date(2021, 5, 5, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"до конца дня": {
+			time.Date(2020, 10, 10, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"к концу недели": {
+			time.Date(2020, 10, 16, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"в конце следующей недели": {
+			time.Date(2020, 10, 16, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"начало квартала": {
+			time.Date(2021, 1, 1, 9, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"by eod": {
+			time.Date(2020, 10, 10, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"cob friday": {
+			time.Date(2020, 10, 16, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"eod tomorrow": {
+			time.Date(2020, 10, 11, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"eow": {
+			time.Date(2020, 10, 16, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"в середине месяца": {
+			time.Date(2020, 10, 16, 12, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"end of the month": {
+			time.Date(2020, 10, 30, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"до конца года сдать отчёт": {
+			time.Date(2020, 12, 31, 18, 0, 0, 0, dt.Location()),
+			"сдать отчёт",
+		},
+		"завтра до конца дня": {
+			time.Date(2020, 10, 11, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"конец прошлого квартала": {
+			time.Date(2020, 9, 30, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"через 1 час 30 минут": {
+			time.Date(dt.Year(), dt.Month(), 10, 13, 31, 0, 0, dt.Location()),
+			"",
+		},
+		"in 2 days and 3 hours": {
+			time.Date(dt.Year(), dt.Month(), 12, 15, 1, 0, 0, dt.Location()),
+			"",
+		},
+		"1h30m": {
+			time.Date(dt.Year(), dt.Month(), 10, 13, 31, 0, 0, dt.Location()),
+			"",
+		},
+		"2д4ч": {
+			time.Date(dt.Year(), dt.Month(), 12, 16, 1, 0, 0, dt.Location()),
+			"",
+		},
+		"2д 4ч позвонить": {
+			time.Date(dt.Year(), dt.Month(), 12, 16, 1, 0, 0, dt.Location()),
+			"позвонить",
+		},
+		"через час 15 минут поесть": {
+			time.Date(dt.Year(), dt.Month(), 10, 13, 16, 0, 0, dt.Location()),
+			"поесть",
+		},
+		"через 2 дня, 3 часа и 10 минут": {
+			time.Date(dt.Year(), dt.Month(), 12, 15, 11, 0, 0, dt.Location()),
+			"",
+		},
+		"через 1,5 часа": {
+			time.Date(dt.Year(), dt.Month(), 10, 13, 31, 0, 0, dt.Location()),
+			"",
+		},
+		"in an hour and a half": {
+			time.Date(dt.Year(), dt.Month(), 10, 13, 31, 0, 0, dt.Location()),
+			"",
+		},
+		"next friday": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+13, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"call next friday at 15:00": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()+13, 15, 0, 0, 0, dt.Location()),
+			"call",
+		},
+		"last monday": {
+			time.Date(dt.Year(), dt.Month(), dt.Day()-5, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"на Новый год": {
+			time.Date(2021, 1, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"на Рождество": {
+			time.Date(2021, 1, 7, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"on Thanksgiving": {
+			time.Date(2020, 11, 26, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"на Пасху": {
+			time.Date(2021, 5, 2, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"on easter": {
+			time.Date(2021, 4, 4, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"на масленицу напечь блинов": {
+			time.Date(2021, 3, 8, 18, 0, 0, 0, dt.Location()),
+			"напечь блинов",
+		},
+		"ко дню победы в 10:00": {
+			time.Date(2021, 5, 9, 10, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"к новому году": {
+			time.Date(2021, 1, 1, 18, 0, 0, 0, dt.Location()),
+			"",
+		},
+		"christmas eve party": {
+			time.Date(2020, 12, 24, 18, 0, 0, 0, dt.Location()),
+			"party",
+		},
 		// FIXME:
 		//"в субботу в 11 утра": {
 		//	time.Date(dt.Year(), dt.Month(), dt.Day()+7, 11, 0, 0, 0, dt.Location()),
@@ -652,6 +1208,262 @@ func TestDateparse(t *testing.T) {
 	}
 }
 
+func TestParseResultCorrections(t *testing.T) {
+	dt := time.Date(2020, 10, 10, 12, 1, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		input       string
+		message     string
+		corrections []Correction
+	}{
+		{"звтра позвонить", "позвонить", []Correction{{"звтра", "завтра"}}},
+		{"в понеделник", "", []Correction{{"понеделник", "понедельник"}}},
+		{"wendesday call tommorow", "wendesday call", []Correction{{"tommorow", "tomorrow"}}},
+		{"среди недели", "", nil},
+		{"tommorow tomorrow call", "tomorrow call", []Correction{{"tommorow", "tomorrow"}}},
+		{"завтра съесть завтрак", "съесть завтрак", nil},
+		{"минус марка sundae match tomorrows", "", nil},
+		{"завтро позвонить", "позвонить", []Correction{{"завтро", "завтра"}}},
+		{"во вторнек", "", []Correction{{"вторнек", "вторник"}}},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			res := ParseResult(tt.input, &Opts{Now: dt})
+			if res.Message != tt.message {
+				t.Errorf("message: got '%s' want '%s'", res.Message, tt.message)
+			}
+			if len(res.Corrections) != len(tt.corrections) {
+				t.Fatalf("corrections: got %v want %v", res.Corrections, tt.corrections)
+			}
+			for i := range tt.corrections {
+				if res.Corrections[i] != tt.corrections[i] {
+					t.Errorf("corrections: got %v want %v", res.Corrections, tt.corrections)
+				}
+			}
+		})
+	}
+}
+
+func TestParseNoDate(t *testing.T) {
+	dt := time.Date(2020, 10, 10, 12, 1, 0, 0, time.UTC)
+	for _, input := range []string{
+		"review the first draft",
+		"read the third chapter",
+		"chapter 10 of 12",
+		"нужно 10 to 12 человек",
+		"нужно 5-10 человек",
+		"встреча 10-12 человек",
+		"в 1975",
+		"в 24:30",
+		"через полтора рабочих дня",
+		"in 1.5 business days",
+		"поздравляю с новым годом",
+		"с днём победы!",
+		"merry christmas",
+		"happy new year",
+		"с 8 марта!",
+		"поздравляю с 23 февраля !",
+		"happy labor day",
+		"до нового года",
+		"grammar",
+		"spam",
+		"second",
+		"wait a second",
+		"a mouth",
+		"grammar first",
+		"year",
+	} {
+		t.Run(input, func(t *testing.T) {
+			if got, _ := Parse(input, &Opts{Now: dt}); !got.IsZero() {
+				t.Errorf("dateparse error on '%s': got '%s' want no date", input, got)
+			}
+		})
+	}
+}
+
+func TestParseResultLocation(t *testing.T) {
+	dt := time.Date(2020, 10, 10, 12, 1, 0, 0, time.UTC)
+	for input, want := range map[string]string{
+		"завтра в 10 по мск":          "MSK",
+		"tomorrow 9am utc-3":          "UTC-3",
+		"в 15 по владивостоку":        "Asia/Vladivostok",
+		"tomorrow at noon":            "",
+		"tomorrow we head west":       "",
+		"tomorrow wet paint":          "",
+		"встреча в 12 по europe/kiev": "Europe/Kiev",
+	} {
+		t.Run(input, func(t *testing.T) {
+			res := ParseResult(input, &Opts{Now: dt})
+			got := ""
+			if res.Location != nil {
+				got = res.Location.String()
+			}
+			if got != want {
+				t.Errorf("location: got '%s' want '%s'", got, want)
+			}
+			if res.Date.Location() != time.UTC {
+				t.Errorf("date must stay in the caller's location, got %s", res.Date.Location())
+			}
+		})
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	dt := time.Date(2020, 10, 10, 12, 1, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		input    string
+		date     time.Time
+		message  string
+		location string
+	}{
+		{"deploy 2024-03-05T07:00:00.25Z done", time.Date(2024, 3, 5, 7, 0, 0, 250000000, time.UTC), "deploy done", "UTC"},
+		{"2024-03-05 10:00:00.123456789-05:00", time.Date(2024, 3, 5, 15, 0, 0, 123456789, time.UTC), "", "UTC-05:00"},
+		{"2024-03-05t10:00:59.9", time.Date(2024, 3, 5, 10, 0, 59, 900000000, time.UTC), "", ""},
+		{"Tue, 5 Mar 2024 10:00 GMT", time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC), "", "GMT"},
+		{"1709625600123", time.Date(2024, 3, 5, 8, 0, 0, 123000000, time.UTC), "", ""},
+		{"@1709625600.5 tick", time.Date(2024, 3, 5, 8, 0, 0, 500000000, time.UTC), "tick", ""},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			res := ParseResult(tt.input, &Opts{Now: dt})
+			if !res.Date.Equal(tt.date) || res.Message != tt.message {
+				t.Errorf("got '%s' (comment: '%s') want '%s' (comment: '%s')", res.Date, res.Message, tt.date, tt.message)
+			}
+			location := ""
+			if res.Location != nil {
+				location = res.Location.String()
+			}
+			if location != tt.location {
+				t.Errorf("location: got '%s' want '%s'", location, tt.location)
+			}
+		})
+	}
+}
+
+func TestParseTimestampRejects(t *testing.T) {
+	dt := time.Date(2020, 10, 10, 12, 1, 0, 0, time.UTC)
+	for _, input := range []string{
+		"order 1234567890 shipped",
+		"build 2024-13-32t10:00:00z",
+		"mon, 31 feb 2024 10:00:00 +0000",
+	} {
+		t.Run(input, func(t *testing.T) {
+			if date, _, _, ok := parseTimestamp(input, Opts{Now: dt}); ok {
+				t.Errorf("got timestamp '%s'", date)
+			}
+		})
+	}
+}
+
+func TestParseResultYears(t *testing.T) {
+	dt := time.Date(2020, 10, 10, 12, 1, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		input       string
+		pivot       int
+		year        int
+		granularity Granularity
+	}{
+		{"в 2027 году", 0, 2027, GranularityYear},
+		{"in 1999", 0, 1999, GranularityYear},
+		{"2031 год", 0, 2031, GranularityYear},
+		{"12 апреля 1987 г.", 0, 1987, GranularityTime},
+		{"12.04.68", 0, 2068, GranularityTime},
+		{"12.04.69", 0, 1969, GranularityTime},
+		{"12.04.68", 50, 1968, GranularityTime},
+		{"12.04.30", 50, 2030, GranularityTime},
+		{"завтра", 0, 2020, GranularityTime},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			res := ParseResult(tt.input, &Opts{Now: dt, YearPivot: tt.pivot})
+			if res.Date.Year() != tt.year || res.Granularity != tt.granularity {
+				t.Errorf("got %d (granularity %d) want %d (granularity %d)", res.Date.Year(), res.Granularity, tt.year, tt.granularity)
+			}
+		})
+	}
+}
+
+func TestParseResultPeriods(t *testing.T) {
+	dt := time.Date(2020, 10, 10, 12, 1, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		input       string
+		anchor      Anchor
+		date        time.Time
+		granularity Granularity
+	}{
+		{"в марте", AnchorStart, time.Date(2021, 3, 1, 18, 0, 0, 0, time.UTC), GranularityMonth},
+		{"в марте", AnchorMiddle, time.Date(2021, 3, 16, 18, 0, 0, 0, time.UTC), GranularityMonth},
+		{"в феврале", AnchorEnd, time.Date(2021, 2, 28, 18, 0, 0, 0, time.UTC), GranularityMonth},
+		{"летом", AnchorEnd, time.Date(2021, 8, 31, 18, 0, 0, 0, time.UTC), GranularitySeason},
+		{"в следующем году", AnchorMiddle, time.Date(2021, 7, 2, 18, 0, 0, 0, time.UTC), GranularityYear},
+		{"в 2024 году", AnchorEnd, time.Date(2024, 12, 31, 18, 0, 0, 0, time.UTC), GranularityYear},
+		{"this month", AnchorEnd, time.Date(2020, 10, 31, 18, 0, 0, 0, time.UTC), GranularityMonth},
+		{"в марте в 10 утра", AnchorStart, time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC), GranularityTime},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			res := ParseResult(tt.input, &Opts{Now: dt, PeriodAnchor: tt.anchor})
+			if !res.Date.Equal(tt.date) || res.Granularity != tt.granularity {
+				t.Errorf("got '%s' (granularity %d) want '%s' (granularity %d)", res.Date, res.Granularity, tt.date, tt.granularity)
+			}
+		})
+	}
+}
+
+func TestParseBoundaryRolling(t *testing.T) {
+	dt := time.Date(2021, 1, 31, 12, 1, 0, 0, time.UTC)
+	for input, want := range map[string]time.Time{
+		"в середине месяца": time.Date(2021, 2, 15, 12, 0, 0, 0, time.UTC),
+		"начало квартала":   time.Date(2021, 4, 1, 9, 0, 0, 0, time.UTC),
+		"к концу месяца":    time.Date(2021, 2, 26, 18, 0, 0, 0, time.UTC),
+	} {
+		t.Run(input, func(t *testing.T) {
+			got, _ := Parse(input, &Opts{Now: dt})
+			if !got.Equal(want) {
+				t.Errorf("got '%s' want '%s'", got, want)
+			}
+		})
+	}
+}
+
+func TestParseSprintBoundary(t *testing.T) {
+	dt := time.Date(2020, 10, 10, 12, 1, 0, 0, time.UTC)
+	sprintStart := time.Date(2020, 9, 30, 0, 0, 0, 0, time.UTC)
+	for input, want := range map[string]time.Time{
+		"end of sprint":            time.Date(2020, 10, 13, 18, 0, 0, 0, time.UTC),
+		"начало спринта":           time.Date(2020, 10, 14, 9, 0, 0, 0, time.UTC),
+		"конец следующего спринта": time.Date(2020, 10, 27, 18, 0, 0, 0, time.UTC),
+	} {
+		t.Run(input, func(t *testing.T) {
+			got, _ := Parse(input, &Opts{Now: dt, SprintStart: sprintStart})
+			if !got.Equal(want) {
+				t.Errorf("got '%s' want '%s'", got, want)
+			}
+		})
+	}
+
+	// without SprintStart the sprints are counted from a fixed monday and do not move with the week
+	first, _ := Parse("end of sprint", &Opts{Now: time.Date(2020, 9, 30, 12, 0, 0, 0, time.UTC)})
+	second, _ := Parse("end of sprint", &Opts{Now: time.Date(2020, 10, 7, 12, 0, 0, 0, time.UTC)})
+	if want := time.Date(2020, 10, 9, 18, 0, 0, 0, time.UTC); !first.Equal(want) || !second.Equal(want) {
+		t.Errorf("got '%s' and '%s' want '%s'", first, second, want)
+	}
+}
+
+func TestVocabularyLookup(t *testing.T) {
+	for _, word := range []string{"a", "ма", "|", "янв|", ""} {
+		if m, ok := parseMonth(word); ok {
+			t.Errorf("parseMonth('%s') = %v, want unknown", word, m)
+		}
+	}
+	for _, word := range []string{"с", "пят", "thu|fri", "на"} {
+		if d, ok := parseWeekDays(word); ok {
+			t.Errorf("parseWeekDays('%s') = %v, want unknown", word, d)
+		}
+	}
+	if m, ok := parseMonth("марта"); !ok || m != time.March {
+		t.Errorf("parseMonth('марта') = %v, want March", m)
+	}
+	if d, ok := parseWeekDays("thu"); !ok || d != time.Thursday {
+		t.Errorf("parseWeekDays('thu') = %v, want Thursday", d)
+	}
+}
+
 // BenchmarkParse-12    	   15781	     76097 ns/op	     494 B/op	      14 allocs/op
 // ==>
 // BenchmarkParse-12    	   16088	     75671 ns/op	     439 B/op	       8 allocs/op
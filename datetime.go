@@ -10,19 +10,35 @@ import (
 var dateTimeRegex, _ = joinRegexp([]*regexp.Regexp{baseDurOnlyRegex, baseWeekOnlyRegex, baseWeekPrefixRegex, baseWeekPrefixOnlyRegex,
 	baseDurRegex, baseWeekRegex, baseTimeOrientationRegex, durTimeRegex, baseDurTimeRegex, durRegex, wdsSuffuxRegex, wdsRegex,
 	ddRegex, ddmmRegex, ddMonthRegex, ddmmyyyyRegex, mmddyyyyRegex, mmddRegex, ddMonthyyyyRegex, ddmmyyRegex, mmddyyRegex,
-	ddMonthyyRegex, durPrefixWeekRegex, weekDurSuffixRegex, durSuffixWeekRegex, hhmmRegex, hhRegex, isoyyyymmddRegex, isoyymmddRegex, wdsTimeRegex}, "|")
+	ddMonthyyRegex, durPrefixWeekRegex, enWeekModifierRegex, weekDurSuffixRegex, durSuffixWeekRegex, clock12Regex, hhmmRegex, hhmmDashRegex, militaryRegex, hourOnlyRegex, hhRegex, hhWordsRegex, isoyyyymmddRegex, isoyymmddRegex, wdsTimeRegex,
+	ordinalMonthRegex, monthOrdinalRegex, ordinalDayRegex, theOrdinalDayRegex, monthddRegex, monthddyyyyRegex,
+	ruHalfRegex, ruQuarterRegex, compoundDurRegex, agoDurRegex, compactDurRegex, boundaryRegex, boundaryAbbrRegex, workdayDurRegex, workdayAgoRegex, nextWorkdayRegex, holidayRegex, weekendRegex, yearRegex, enYearRegex, monthPeriodRegex, isoMonthRegex, seasonRegex, relativePeriodRegex, ruMinutesPastRegex, ruWithoutRegex, enPastRegex, enToRegex}, "|")
 
-func dateTimeParse(s string, opts Opts) (t time.Time, msg string) {
-	if dateTimeRegex.MatchString(s) {
+// spans are the pieces of the input the date and the time were read from,
+// clock tells a time of day given in the text or an exact moment from a duration
+func dateTimeParse(in text, opts Opts) (t time.Time, granularity Granularity, spans []span, clock bool) {
+	if dateTimeRegex.MatchString(in.s) {
 
 		marker := getMarker()
-		s = strings.ReplaceAll(s, "://", marker)
+		in = in.replaceAll("://", marker)
+		in = maskGreetings(in, getMarker())
+		in = maskFractionalWorkdays(in, getMarker())
 
-		date, replacingDate := parseDate(s, opts)
-		s = strings.Replace(s, strings.TrimSpace(replacingDate), "", 1)
-		timeP, replacingTime := parseTime(s, opts)
+		var date, timeP time.Time
+		var replacingDate, replacingTime string
+		if clockFirst(in.s) {
+			timeP, replacingTime = parseTime(in.s, opts)
+			in, spans = removeSpan(in, replacingTime, spans)
+			date, replacingDate = parseDate(in.s, opts)
+			in, spans = removeSpan(in, replacingDate, spans)
+		} else {
+			date, replacingDate = parseDate(in.s, opts)
+			in, spans = removeSpan(in, replacingDate, spans)
+			timeP, replacingTime = parseTime(in.s, opts)
+			in, spans = removeSpan(in, replacingTime, spans)
+		}
 		if (timeP.Before(opts.Now) || timeP == opts.Now) && date == opts.Now {
-			date = date.Add(24 * time.Hour)
+			date = date.AddDate(0, 0, 1)
 		}
 
 		hour := timeP.Hour()
@@ -31,19 +47,38 @@ func dateTimeParse(s string, opts Opts) (t time.Time, msg string) {
 
 		replacingTime = strings.TrimSpace(replacingTime)
 		if len(replacingTime) == 0 {
+			granularity = dateGranularity(replacingDate)
 			hour = date.Hour()
 			minute = date.Minute()
 			second = date.Second()
+		} else if timeP.Equal(getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day()+1, 0, 0, 0, opts)) {
+			// 24:00 is the end of the date
+			date = date.AddDate(0, 0, 1)
 		}
 
-		s = strings.Replace(s, replacingTime, "", 1)
-		s = strings.ReplaceAll(s, marker, "://")
-
-		return getDate(date.Year(), date.Month(), date.Day(), hour, minute, second, opts), strings.TrimSpace(s)
+		for i := range spans {
+			spans[i].text = strings.ReplaceAll(spans[i].text, marker, "://")
+		}
+		clock = replacingTime != "" || hour != opts.TodayEndHour || minute != 0 || second != 0
+		return getDate(date.Year(), date.Month(), date.Day(), hour, minute, second, opts), granularity, spans, clock
 	}
 	return
 }
 
+func removeSpan(in text, match string, spans []span) (text, []span) {
+	match = strings.TrimSpace(match)
+	i := strings.Index(in.s, match)
+	if i < 0 || match == "" {
+		return in, spans
+	}
+	return in.replace(i, i+len(match), ""), append(spans, in.span(i, i+len(match)))
+}
+
+func matchesWhole(re *regexp.Regexp, s string) bool {
+	s = strings.TrimSpace(s)
+	return s != "" && strings.TrimSpace(re.FindString(s)) == s
+}
+
 func joinRegexp(regexps []*regexp.Regexp, sep string) (*regexp.Regexp, error) {
 	var b strings.Builder
 	for i, re := range regexps {
@@ -55,8 +90,11 @@ func joinRegexp(regexps []*regexp.Regexp, sep string) (*regexp.Regexp, error) {
 	return regexp.Compile(b.String())
 }
 
+// private use runes never match any of the date patterns, random bytes may spell digits or words
 func getMarker() string {
-	b := make([]byte, 20)
-	rand.Read(b)
+	b := make([]rune, 20)
+	for i := range b {
+		b[i] = rune(0xE000 + rand.Intn(0x1900))
+	}
 	return string(b)
 }
@@ -1,96 +1,267 @@
 package dateparse
 
 import (
+	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 )
 
+// the declined forms of the units in the order of the paradigms in morph.go, the formatter in locale.go
+// speaks them too
 var (
-	seconds           = `сек|секунд|sec|seconds`
-	minutes           = `мин|минут|минуту|минуты|min`
-	hours             = `часов|hours|hour|часа|час`
-	days              = `дней|дня|days`
-	weeksWords        = `недель|неделю|недели|неделя|weeks|week`
-	monthsWords       = `месяцев|месяца|month`
-	years             = `лет|года|год|years`
-	durationTimeWords = strings.Join([]string{seconds, minutes, hours, days, weeksWords, monthsWords, years}, "|")
+	ruSecondForms = ruForms("секунд", femHard)
+	ruMinuteForms = ruForms("минут", femHard)
+	ruHourForms   = ruForms("час", mascHard)
+	ruDayForms    = `день|дня|дню|днём|дне|дни|дней|дням|днями|днях`
+	ruWeekForms   = ruForms("недел", femSoft)
+	ruMonthForms  = ruForms("месяц", mascTs)
+	ruYearForms   = `год|года|году|годом|годе|годы|лет|годам|годами|годах`
+
+	// singular, then plural
+	enSecondForms = `second|seconds`
+	enMinuteForms = `minute|minutes`
+	enHourForms   = `hour|hours`
+	enDayForms    = `day|days`
+	enWeekForms   = `week|weeks`
+	enMonthForms  = `month|months`
+	enYearForms   = `year|years`
+)
+
+var (
+	seconds = alternation(ruSecondForms, ruForms("секундочк", femVelar), ruForms("секундк", femVelar), enSecondForms,
+		`сек|secs|sec`)
+	minutes = alternation(ruMinuteForms, ruForms("минуточк", femVelar), ruForms("минутк", femVelar), enMinuteForms,
+		`мин|mins|min`)
+	hours = alternation(ruHourForms, ruForms("часик", mascVelar), ruForms("час", []string{"ок", "ика"}), enHourForms,
+		`hrs|hr`)
+	days        = alternation(ruDayForms, enDayForms, `суток|сутки`)
+	weeksWords  = alternation(ruWeekForms, ruForms("неделечк", femVelar), enWeekForms)
+	monthsWords = alternation(ruMonthForms, enMonthForms)
+	years       = alternation(ruYearForms, enYearForms, `годов`)
+
+	durationTimeWords = alternation(seconds, minutes, hours, days, weeksWords, monthsWords, years)
+)
+
+type durationUnit int
+
+const (
+	unitUnknown durationUnit = iota
+	unitSecond
+	unitMinute
+	unitHour
+	unitDay
+	unitWeek
+	unitMonth
+	unitYear
+)
+
+var unitLookup = makeLookup(map[int]string{
+	int(unitSecond): seconds,
+	int(unitMinute): minutes,
+	int(unitHour):   hours,
+	int(unitDay):    days,
+	int(unitWeek):   weeksWords,
+	int(unitMonth):  monthsWords,
+	int(unitYear):   years,
+})
+
+var unitDurations = []time.Duration{
+	unitSecond: time.Second,
+	unitMinute: time.Minute,
+	unitHour:   time.Hour,
+	unitDay:    24 * time.Hour,
+	unitWeek:   7 * 24 * time.Hour,
+	unitMonth:  30 * 24 * time.Hour,  // approximate, dates move by whole calendar months
+	unitYear:   365 * 24 * time.Hour, // approximate, dates move by whole calendar years
+}
+
+func parseUnit(s string) durationUnit { return durationUnit(unitLookup[s]) }
+
+var (
+	nextWords = alternation(ruForms("следующ", adjSoft), `next`)
+	lastWords = alternation(ruForms("прошл", adjHard), ruForms("предыдущ", adjSoft), `last|previous`)
+	thisWords = alternation(`этот|эта|это|эти|этого|этой|этому|эту|этим|этом|этих|этими|this`)
 )
 
 var (
-	durPrefix      = `(через|in|следующ[и]?[й]?[у]?[ю]?|следующий|следующую|next)`
+	durPrefix      = `(через|in|` + nextWords + `)`
 	duration       = strings.Join([]string{today, tomorrow, afterTomorrow, afterAfterTomorrow, yesterday}, "|")
-	durationTime   = `сек[у]?[н]?[д]?[а]?[у]?|мин[у]?[т]?[у]?[а]?[ы]?|min[u]?[t]?[e]?[s]?|час[о]?[в]?[а]?|hour[s]?|дн[е]?[й]?[я]?|day[s]?|недел[ь]?[я]?[и]?[ю]?|week[s]?|год|year[s]?|месяц[а]?[е]?[в]?|month[s]?`
+	durationTime   = durationTimeWords
 	durationWds    = strings.Join([]string{duration, durationTime}, "|")
-	durationSuffix = `(утр[а]?[о]?[м]?|morning|вечер[а]?[о]?[м]?|evening|\\|/|днем|полдень|полночь|midday|noon|midnight|ночью)`
+	durationSuffix = `(` + boundedWords(alternation(morning, evening, `днем|днём|полдень|полночь|midday|noon|midnight|ночью`)) + `|\\|/)`
 )
 
 var (
-	quarter     = `quarter|четверть`
-	half        = `half|пол`
-	one         = `one|один`
-	two         = `two|два`
-	three       = `three|три`
-	four        = `four|четыре`
-	five        = `five|пять`
-	six         = `six|шесть`
-	seven       = `seven|семь`
-	eight       = `eight|восемь`
-	nine        = `nine|девять`
-	ten         = `ten|десять`
-	wordNumbers = strings.Join([]string{quarter, half, one, two, three, four, five, six, seven, eight, nine, ten}, "|")
+	durPrefixSet = makeSet(durPrefix)
+	lastWordsSet = makeSet(lastWords)
 )
 
 func checkWordNumber(s string) float64 {
 	if v := forceFloat64(s); v != 0 {
 		return v
 	}
-	switch {
-	case strings.Contains(quarter, s):
-		return 0.25
-	case strings.Contains(half, s):
-		return 0.5
-	case strings.Contains(one, s):
-		return 1
-	case strings.Contains(two, s):
-		return 2
-	case strings.Contains(three, s):
-		return 3
-	case strings.Contains(four, s):
-		return 4
-	case strings.Contains(five, s):
-		return 5
-	case strings.Contains(six, s):
-		return 6
-	case strings.Contains(seven, s):
-		return 7
-	case strings.Contains(eight, s):
-		return 8
-	case strings.Contains(nine, s):
-		return 9
-	case strings.Contains(ten, s):
-		return 10
-	}
-	return 0
+	v, _ := parseCardinal(s)
+	return v
 }
 
 func calculateDuration(m []string, opts Opts, k int) (time.Time, string) {
-	dur := durationParse(m[k:], opts)
-	if dur > 0 {
-		return opts.Now.Add(dur).In(opts.Now.Location()), m[0]
+	v, unit := durationParse(m[k:], opts)
+	if v > 0 {
+		return addDuration(opts.Now, v, unit), m[0]
 	}
 	return opts.Now, m[0]
 }
 
-func durationParse(bits []string, opts Opts) (dur time.Duration) {
-	if strings.Contains(durPrefix, bits[0]) {
+var (
+	andHalf         = `(?:с[" "]половиной|and[" "]a[" "]half)`
+	durationPair    = fmt.Sprintf(`(?:(?:\d+(?:[.,]\d+)?|%s)[" "]?)?(?:%s[" "])?(?:%s)(?:[" "]%s)?`, wordNumbers, andHalf, durationTime, andHalf)
+	durationPairSep = `(?:,?[" "](?:и[" "]|and[" "])?)`
+	compactUnits    = alternation(`s|с|сек`, `m|м|мин`, `h|ч`, `d|д`, `w|н|нед`)
+
+	// number words in a row are read as one count, so "twenty ten" is rejected rather than read as "ten"
+	countWords = fmt.Sprintf(`(?:%s)(?:[ -](?:%s))*`, wordNumbers, wordNumbers)
+	// a unit alone is not a duration: "3 часа", "half an hour" and "час с половиной" are, "на неделе" is not
+	countedPair = fmt.Sprintf(`(?:(?:\d+(?:[.,]\d+)?|%s)[" "]?(?:%s[" "])?(?:%s)(?:[" "]%s)?|(?:%s)[" "]%s)`,
+		countWords, andHalf, durationTime, andHalf, durationTime, andHalf)
+)
+
+var (
+	durationPairRegex = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(?:(\d+(?:[.,]\d+)?|%s)[" "]?)?(%s[" "])?(%s)([" "]%s)?`, countWords, andHalf, durationTime, andHalf))
+	compactPieceRegex = regexp.MustCompile(fmt.Sprintf(`(\d+(?:[.,]\d+)?)[" "]?(%s)`, compactUnits))
+)
+
+var (
+	durationSpanRegex = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(%s(?:%s%s)*)(?:[" "]|$|[.,])`, countedPair, durationPairSep, countedPair))
+	compactSpanRegex  = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])((?:\d+(?:[.,]\d+)?[" "]?(?:%s)[" "]?)+)(?:[" "]|$|[.,])`, compactUnits))
+	unknownUnitRegex  = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(?:\d+(?:[.,]\d+)?|%s)[" "]?([^\d\s.,]+)`, wordNumbers))
+	bareNumberRegex   = regexp.MustCompile(`^(\d+(?:[.,]\d+)?)$`)
+)
+
+var compactUnitLookup = makeLookup(map[int]string{
+	int(unitSecond): `s|с|сек`,
+	int(unitMinute): `m|м|мин`,
+	int(unitHour):   `h|ч`,
+	int(unitDay):    `d|д`,
+	int(unitWeek):   `w|н|нед`,
+})
+
+// "через 1 час 30 минут", "in 2 days and 3 hours", "через час с половиной"
+func calculateCompoundDuration(m []string, opts Opts) (time.Time, string) {
+	return addDurationPairs(opts.Now, m[len(m)-1], 1), m[0]
+}
+
+// "3 дня назад", "2 hours 5 minutes ago"
+func calculateAgoDuration(m []string, opts Opts) (time.Time, string) {
+	return addDurationPairs(opts.Now, m[1], -1), m[0]
+}
+
+func addDurationPairs(date time.Time, s string, sign float64) time.Time {
+	for _, pair := range durationPairRegex.FindAllStringSubmatch(s, -1) {
+		v := 1.0
+		if pair[1] != "" {
+			v = checkWordNumber(strings.Replace(pair[1], ",", ".", 1))
+		}
+		if pair[2] != "" || pair[4] != "" {
+			v += 0.5
+		}
+		date = addDuration(date, sign*v, parseUnit(pair[3]))
+	}
+	return date
+}
+
+// "1h30m" and "2д 4ч" need a prefix when there is only one piece: "через 5м"
+func hasCompactDuration(s string) bool {
+	m := compactDurRegex.FindStringSubmatch(s)
+	if m == nil {
+		return false
+	}
+	if m[1] != "" {
+		return durPrefixSet[m[1]]
+	}
+	return len(compactPieceRegex.FindAllString(m[len(m)-1], -1)) > 1
+}
+
+func calculateCompactDuration(m []string, opts Opts) (time.Time, string) {
+	date := opts.Now
+	for _, piece := range compactPieceRegex.FindAllStringSubmatch(m[len(m)-1], -1) {
+		v := checkWordNumber(strings.Replace(piece[1], ",", ".", 1))
+		date = addDuration(date, v, durationUnit(compactUnitLookup[piece[2]]))
+	}
+	return date, m[0]
+}
+
+// a fixed length of the first duration in the text, months and years are approximate
+func parseDurationSpan(s string) (time.Duration, string, error) {
+	var dur time.Duration
+	switch {
+	case durationSpanRegex.MatchString(s):
+		span := strings.TrimSpace(durationSpanRegex.FindStringSubmatch(s)[1])
+		for _, pair := range durationPairRegex.FindAllStringSubmatch(span, -1) {
+			number := pair[1]
+			if number == "" {
+				number = "1"
+			}
+			unit := parseUnit(pair[3])
+			d, err := unitValue(number, unit)
+			if err != nil {
+				return 0, "", err
+			}
+			dur += d
+			if pair[2] != "" || pair[4] != "" {
+				dur += unitDurations[unit] / 2
+			}
+		}
+		return dur, span, nil
+	case compactSpanRegex.MatchString(s):
+		span := strings.TrimSpace(compactSpanRegex.FindStringSubmatch(s)[1])
+		for _, piece := range compactPieceRegex.FindAllStringSubmatch(span, -1) {
+			d, err := unitValue(piece[1], durationUnit(compactUnitLookup[piece[2]]))
+			if err != nil {
+				return 0, "", err
+			}
+			dur += d
+		}
+		return dur, span, nil
+	case bareNumberRegex.MatchString(s):
+		// a bare number means minutes, as in "через 15"
+		dur, err := unitValue(s, unitMinute)
+		if err != nil {
+			return 0, "", err
+		}
+		return dur, s, nil
+	case unknownUnitRegex.MatchString(s):
+		word := unknownUnitRegex.FindStringSubmatch(s)[1]
+		// "в 10 утра" is a time of day, not a duration in unknown units
+		if parseDayPart(word) != partUnknown || parseMeridiem(word) != meridiemUnknown {
+			return 0, "", ErrNoDuration
+		}
+		return 0, "", fmt.Errorf("dateparse: unknown duration unit %q", word)
+	}
+	return 0, "", ErrNoDuration
+}
+
+func unitValue(number string, unit durationUnit) (time.Duration, error) {
+	v, err := strconv.ParseFloat(strings.Replace(number, ",", ".", 1), 64)
+	if err != nil {
+		var ok bool
+		if v, ok = parseCardinal(number); !ok {
+			return 0, fmt.Errorf("dateparse: unknown duration count %q", number)
+		}
+	}
+	return time.Duration(v * float64(unitDurations[unit])), nil
+}
+
+func durationParse(bits []string, opts Opts) (float64, durationUnit) {
+	if durPrefixSet[bits[0]] {
 		return durationParse(normalizeStrings(bits[1:]), opts)
 	}
 
 	switch len(bits) {
 	case 1:
 		word := bits[0]
-		switch {
-		case strings.Contains(durationTimeWords, word):
+		if parseUnit(word) != unitUnknown {
 			return durationParse([]string{"1", word}, opts)
 		}
 		if forceInt64(word) > 0 {
@@ -99,49 +270,40 @@ func durationParse(bits []string, opts Opts) (dur time.Duration) {
 	case 2:
 		v := checkWordNumber(bits[0])
 		if v == 0 {
-			return
+			return 0, unitUnknown
 		}
-		word := strings.TrimSpace(bits[1])
-		if v < 1 && v >= 0 {
-			div := time.Duration(1 / v)
-			switch {
-			case strings.Contains(seconds, word):
-				return time.Second / div
-			case strings.Contains(minutes, word):
-				return time.Minute / div
-			case strings.Contains(hours, word):
-				return time.Hour / div
-			case strings.Contains(days, word):
-				return time.Hour * 12
-			case strings.Contains(weeksWords, word):
-				return time.Hour * 12 * 7
-			case strings.Contains(monthsWords, word):
-				return time.Hour * 12 * 31 // XXX:
-			case strings.Contains(years, word):
-				return time.Hour * 12 * 365 // XXX:
-			default:
-				return durationParse(bits[:1], opts)
-			}
-		}
-
-		switch {
-		case strings.Contains(seconds, word):
-			return time.Duration(v) * time.Second
-		case strings.Contains(minutes, word):
-			return time.Duration(v) * time.Minute
-		case strings.Contains(hours, word):
-			return time.Duration(v) * time.Hour
-		case strings.Contains(days, word):
-			return time.Duration(v) * time.Hour * 24
-		case strings.Contains(weeksWords, word):
-			return time.Duration(v) * time.Hour * 24 * 7
-		case strings.Contains(monthsWords, word):
-			return time.Duration(v) * time.Hour * 24 * 31 // XXX:
-		case strings.Contains(years, word):
-			return time.Duration(v) * time.Hour * 24 * 365 // XXX:
-		default:
+		unit := parseUnit(strings.TrimSpace(bits[1]))
+		if unit == unitUnknown {
 			return durationParse(bits[:1], opts)
 		}
+		return v, unit
+	}
+	return 0, unitUnknown
+}
+
+// days and weeks are counted on the wall clock, months and years on the calendar
+func addDuration(t time.Time, v float64, unit durationUnit) time.Time {
+	whole := int(v)
+	rest := time.Duration((v - float64(whole)) * float64(unitDurations[unit]))
+	switch unit {
+	case unitDay:
+		return t.AddDate(0, 0, whole).Add(rest)
+	case unitWeek:
+		return t.AddDate(0, 0, 7*whole).Add(rest)
+	case unitMonth:
+		return addMonths(t, whole).Add(rest)
+	case unitYear:
+		return addMonths(t, 12*whole).Add(rest)
+	}
+	return t.Add(time.Duration(v * float64(unitDurations[unit])))
+}
+
+// january 31 plus a month is the last day of february, not march 3
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	day := t.Day()
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
 	}
-	return
+	return first.AddDate(0, 0, day-1)
 }
@@ -2,6 +2,7 @@ package dateparse
 
 import (
 	"testing"
+	"time"
 )
 
 func TestCheckWordNumber(t *testing.T) {
@@ -17,6 +18,66 @@ func TestCheckWordNumber(t *testing.T) {
 			"четверть",
 			0.25,
 		},
+		{
+			"on",
+			0,
+		},
+		{
+			"|",
+			0,
+		},
+		{
+			"десять",
+			10,
+		},
+		{
+			"двадцать пять",
+			25,
+		},
+		{
+			"триста сорок два",
+			342,
+		},
+		{
+			"twenty-one",
+			21,
+		},
+		{
+			"two hundred and five",
+			205,
+		},
+		{
+			"a couple of",
+			2,
+		},
+		{
+			"полутора",
+			1.5,
+		},
+		{
+			"сто сто",
+			0,
+		},
+		{
+			"двести триста",
+			0,
+		},
+		{
+			"десять пять",
+			0,
+		},
+		{
+			"пять двадцать",
+			0,
+		},
+		{
+			"двадцать пол",
+			0,
+		},
+		{
+			"a hundred",
+			100,
+		},
 	} {
 		result := checkWordNumber(tt.input)
 		if result != tt.output {
@@ -24,3 +85,81 @@ func TestCheckWordNumber(t *testing.T) {
 		}
 	}
 }
+
+func TestCalendarArithmetic(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		input string
+		now   time.Time
+		want  time.Time
+	}{
+		{"через месяц", time.Date(2021, 1, 31, 10, 0, 0, 0, time.UTC), time.Date(2021, 2, 28, 10, 0, 0, 0, time.UTC)},
+		{"через 2 месяца", time.Date(2020, 12, 31, 10, 0, 0, 0, time.UTC), time.Date(2021, 2, 28, 10, 0, 0, 0, time.UTC)},
+		{"через год", time.Date(2020, 2, 29, 10, 0, 0, 0, time.UTC), time.Date(2021, 2, 28, 10, 0, 0, 0, time.UTC)},
+		{"через 4 года", time.Date(2020, 2, 29, 10, 0, 0, 0, time.UTC), time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC)},
+		{"in a month", time.Date(2021, 3, 31, 10, 0, 0, 0, time.UTC), time.Date(2021, 4, 30, 10, 0, 0, 0, time.UTC)},
+		{"через день", time.Date(2020, 10, 24, 10, 0, 0, 0, berlin), time.Date(2020, 10, 25, 10, 0, 0, 0, berlin)},
+		{"через неделю", time.Date(2020, 10, 20, 10, 0, 0, 0, berlin), time.Date(2020, 10, 27, 10, 0, 0, 0, berlin)},
+		{"через 3 часа", time.Date(2020, 10, 25, 1, 0, 0, 0, berlin), time.Date(2020, 10, 25, 3, 0, 0, 0, berlin)},
+		{"in 1 month and 2 days", time.Date(2021, 1, 30, 10, 0, 0, 0, time.UTC), time.Date(2021, 3, 2, 10, 0, 0, 0, time.UTC)},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			got, _ := Parse(tt.input, &Opts{Now: tt.now})
+			if !got.Equal(tt.want) {
+				t.Errorf("got '%s' want '%s'", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	for _, tt := range []struct {
+		input string
+		dur   time.Duration
+		span  string
+	}{
+		{"полтора часа", 90 * time.Minute, "полтора часа"},
+		{"2 дня 3 часа", 51 * time.Hour, "2 дня 3 часа"},
+		{"45 min", 45 * time.Minute, "45 min"},
+		{"quarter hour", 15 * time.Minute, "quarter hour"},
+		{"1h30m", 90 * time.Minute, "1h30m"},
+		{"2д 4ч", 52 * time.Hour, "2д 4ч"},
+		{"созвон занял час с половиной", 90 * time.Minute, "час с половиной"},
+		{"worked 2 hours and 15 minutes today", 135 * time.Minute, "2 hours and 15 minutes"},
+		{"1,5 часа", 90 * time.Minute, "1,5 часа"},
+		{"15", 15 * time.Minute, "15"},
+		{"half an hour", 30 * time.Minute, "half an hour"},
+		{"half a day", 12 * time.Hour, "half a day"},
+		{"три с половиной часа", 210 * time.Minute, "три с половиной часа"},
+		{"1 h", time.Hour, "1 h"},
+		{"встреча на следующей неделе, 2 часа", 2 * time.Hour, "2 часа"},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			dur, span, err := ParseDuration(tt.input)
+			if err != nil || dur != tt.dur || span != tt.span {
+				t.Errorf("got %s '%s' (%v) want %s '%s'", dur, span, err, tt.dur, tt.span)
+			}
+		})
+	}
+	if _, _, err := ParseDuration("5 parsecs"); err == nil || err == ErrNoDuration {
+		t.Errorf("unknown unit: got %v", err)
+	}
+	if _, _, err := ParseDuration("3 рабочих дня"); err == nil || err == ErrNoDuration {
+		t.Errorf("working days: got %v", err)
+	}
+	if _, _, err := ParseDuration("twenty ten hours"); err == nil || err == ErrNoDuration {
+		t.Errorf("bad count: got %v", err)
+	}
+	if _, _, err := ParseDuration("встреча на следующей неделе"); err != ErrNoDuration {
+		t.Errorf("a unit without a count: got %v", err)
+	}
+	if _, _, err := ParseDuration("завтра"); err != ErrNoDuration {
+		t.Errorf("no duration: got %v", err)
+	}
+	if _, _, err := ParseDuration("в 10 утра"); err != ErrNoDuration {
+		t.Errorf("time of day: got %v", err)
+	}
+}
@@ -0,0 +1,181 @@
+package dateparse
+
+import (
+	"regexp"
+	"unicode/utf8"
+)
+
+var (
+	tokenRegex = regexp.MustCompile(`[\p{L}\d]+`)
+	letterOnly = regexp.MustCompile(`^\p{L}+$`)
+	digitsOnly = regexp.MustCompile(`^\d+$`)
+)
+
+// real words one changed letter away from the vocabulary, they are not typos
+var realWords = `среди|минус|марка|марку|марки|парта|match|marsh|sundae`
+
+type fuzzyWord struct {
+	word string
+	unit bool
+}
+
+var (
+	fuzzyWords = append(
+		makeFuzzyWords(false, months, weeks, shortWeeks, duration),
+		makeFuzzyWords(true, durationTimeWords)...,
+	)
+	knownWords = makeWordSet(months, weeks, shortWeeks, duration, durationTimeWords, durPrefix, numberWords,
+		morning, evening, midnight, noon, realWords)
+)
+
+type Correction struct {
+	From string
+	To   string
+}
+
+func splitWords(patterns ...string) (words []string) {
+	for _, p := range patterns {
+		for _, w := range splitAlternation(p) {
+			if letterOnly.MatchString(w) {
+				words = append(words, w)
+			}
+		}
+	}
+	return words
+}
+
+func makeFuzzyWords(unit bool, patterns ...string) []fuzzyWord {
+	var res []fuzzyWord
+	for _, w := range splitWords(patterns...) {
+		if utf8.RuneCountInString(w) >= 5 {
+			res = append(res, fuzzyWord{word: w, unit: unit})
+		}
+	}
+	return res
+}
+
+func makeWordSet(patterns ...string) map[string]bool {
+	res := make(map[string]bool)
+	for _, w := range splitWords(patterns...) {
+		res[w] = true
+	}
+	return res
+}
+
+func maxTypos(n int) int {
+	switch {
+	case n < 5:
+		return 0
+	case n < 8:
+		return 1
+	}
+	return 2
+}
+
+// a correction and where its word is in the original input
+type typoFix struct {
+	Correction
+	start, end int
+}
+
+func correctTypos(in text) (text, []typoFix) {
+	var fixes []typoFix
+	afterNumber := false
+	for _, loc := range tokenRegex.FindAllStringIndex(in.s, -1) {
+		word := in.s[loc[0]:loc[1]]
+		if fixed, ok := fixTypo(word, afterNumber); ok {
+			fixes = append(fixes, typoFix{Correction{From: word, To: fixed}, loc[0], loc[1]})
+			word = fixed
+		}
+		afterNumber = digitsOnly.MatchString(word) || checkWordNumber(word) != 0
+	}
+	// rewritten from the end, so the offsets of the words before stay valid
+	for i := len(fixes) - 1; i >= 0; i-- {
+		f := &fixes[i]
+		sp := in.span(f.start, f.end)
+		in = in.replace(f.start, f.end, f.To)
+		f.start, f.end = sp.start, sp.end
+	}
+	return in, fixes
+}
+
+func fixTypo(word string, afterNumber bool) (string, bool) {
+	if knownWords[word] || !letterOnly.MatchString(word) {
+		return "", false
+	}
+	w := []rune(word)
+	max := maxTypos(len(w))
+	if max == 0 {
+		return "", false
+	}
+	best, bestDist, tie := "", max+1, false
+	for _, fw := range fuzzyWords {
+		if fw.unit && !afterNumber || extendsWord(w, []rune(fw.word)) {
+			continue
+		}
+		d := typoDistance(w, []rune(fw.word))
+		switch {
+		case d < bestDist:
+			best, bestDist, tie = fw.word, d, false
+		case d == bestDist && fw.word != best:
+			tie = true
+		}
+	}
+	if best == "" || tie {
+		return "", false
+	}
+	return best, true
+}
+
+// a longer word built on the vocabulary one is another real word rather than a typo: "завтрак", "tomorrows"
+func extendsWord(word []rune, vocab []rune) bool {
+	return len(word) > len(vocab) && string(word[:len(vocab)]) == string(vocab)
+}
+
+// optimal string alignment distance: levenshtein plus adjacent transpositions
+func typoDistance(a, b []rune) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = minInt(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+// only the corrections inside the date are reported, the message keeps the words as they were typed
+func appliedTypos(fixes []typoFix, spans []span) []Correction {
+	var applied []Correction
+	for _, f := range fixes {
+		for _, sp := range spans {
+			if f.start < sp.end && sp.start < f.end {
+				applied = append(applied, f.Correction)
+				break
+			}
+		}
+	}
+	return applied
+}
+
+func minInt(v int, vs ...int) int {
+	for _, x := range vs {
+		if x < v {
+			v = x
+		}
+	}
+	return v
+}
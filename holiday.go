@@ -0,0 +1,223 @@
+package dateparse
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var (
+	newYear           = `новый год|нового года|новому году|новым годом|новом году|new year|new year's day|new years day`
+	newYearEve        = `новогодняя ночь|новогоднюю ночь|новогодней ночи|new year's eve|new years eve`
+	orthodoxChristmas = `рождество|рождества|рождеству|рождеством|orthodox christmas`
+	christmas         = `католическое рождество|католического рождества|католическому рождеству|christmas|christmas day|xmas`
+	christmasEve      = `сочельник|сочельника|сочельнику|christmas eve`
+	valentine         = `день святого валентина|дню святого валентина|дня святого валентина|день влюбленных|день влюблённых|valentine's day|valentines day`
+	defender          = `день защитника отечества|дню защитника отечества|дня защитника отечества`
+	women             = `международный женский день|женский день|женскому дню|женского дня|women's day|womens day`
+	labour            = `первомай|первомая|первомаю|день весны и труда|праздник весны и труда|may day`
+	victory           = `день победы|дню победы|дня победы|днем победы|днём победы|victory day`
+	russia            = `день россии|дню россии|дня россии|russia day`
+	knowledge         = `день знаний|дню знаний|дня знаний`
+	unity             = `день народного единства|дню народного единства|дня народного единства|unity day`
+	halloween         = `хэллоуин|хеллоуин|хэллоуина|хеллоуина|halloween`
+	independence      = `independence day|fourth of july|the fourth of july`
+	orthodoxEaster    = alternation(ruForms("пасх", femVelar[:5]), `orthodox easter`)
+	easter            = alternation(`католическая пасха|католической пасхи|католическую пасху|католической пасхе`, `easter|easter sunday`)
+	maslenitsa        = alternation(ruForms("маслениц", femTs[:6]), `maslenitsa|shrovetide`)
+	thanksgiving      = `день благодарения|дню благодарения|дня благодарения|thanksgiving|thanksgiving day`
+
+	holidays = alternation(newYear, newYearEve, orthodoxChristmas, christmas, christmasEve, valentine, defender, women, labour,
+		victory, russia, knowledge, unity, halloween, independence, orthodoxEaster, easter, maslenitsa, thanksgiving)
+
+	// the oblique forms are read only after a preposition: "ко дню победы" is a date, "с новым годом" is a greeting
+	holidayOblique = `нового года|новому году|новым годом|новом году|новогодней ночи|рождества|рождеству|рождеством|` +
+		`католического рождества|католическому рождеству|сочельника|сочельнику|дню святого валентина|дня святого валентина|` +
+		`дню защитника отечества|дня защитника отечества|женскому дню|женского дня|первомая|первомаю|дню победы|дня победы|` +
+		`днем победы|днём победы|дню россии|дня россии|дню знаний|дня знаний|дню народного единства|дня народного единства|` +
+		`хэллоуина|хеллоуина|пасхи|пасхе|пасхой|католической пасхи|католической пасхе|масленицы|масленице|масленицей|масленицею|` +
+		`дню благодарения|дня благодарения`
+	holidayGreetings = `merry|happy`
+
+	holidayObliqueSet = makeSet(holidayOblique)
+)
+
+type holiday int
+
+const (
+	holidayUnknown holiday = iota
+	holidayNewYear
+	holidayNewYearEve
+	holidayOrthodoxChristmas
+	holidayChristmas
+	holidayChristmasEve
+	holidayValentine
+	holidayDefender
+	holidayWomen
+	holidayLabour
+	holidayVictory
+	holidayRussia
+	holidayKnowledge
+	holidayUnity
+	holidayHalloween
+	holidayIndependence
+	holidayOrthodoxEaster
+	holidayEaster
+	holidayMaslenitsa
+	holidayThanksgiving
+)
+
+var holidayLookup = makeLookup(map[int]string{
+	int(holidayNewYear):           newYear,
+	int(holidayNewYearEve):        newYearEve,
+	int(holidayOrthodoxChristmas): orthodoxChristmas,
+	int(holidayChristmas):         christmas,
+	int(holidayChristmasEve):      christmasEve,
+	int(holidayValentine):         valentine,
+	int(holidayDefender):          defender,
+	int(holidayWomen):             women,
+	int(holidayLabour):            labour,
+	int(holidayVictory):           victory,
+	int(holidayRussia):            russia,
+	int(holidayKnowledge):         knowledge,
+	int(holidayUnity):             unity,
+	int(holidayHalloween):         halloween,
+	int(holidayIndependence):      independence,
+	int(holidayOrthodoxEaster):    orthodoxEaster,
+	int(holidayEaster):            easter,
+	int(holidayMaslenitsa):        maslenitsa,
+	int(holidayThanksgiving):      thanksgiving,
+})
+
+var holidayRegex = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(?:(%s)[" "]|(на|в|во|к|ко|on|at|for|by)[" "])?(?:the[" "])?(%s)(?:[" "]|$|[.,!?])`, holidayGreetings, holidays))
+
+// "с 8 марта!": a holiday date after "с" and before "!" is a greeting too
+var holidayDateGreetingRegex = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(?:с|со)[" "](([0-9]{1,2})[" "](%s))[" "]?!`, months))
+
+func hasHoliday(s string) bool {
+	m := holidayRegex.FindStringSubmatch(s)
+	return m != nil && !isGreeting(m)
+}
+
+// "merry christmas" and "с днём победы" are greetings, not dates
+func isGreeting(m []string) bool {
+	return m[1] != "" || m[2] == "" && holidayObliqueSet[m[3]]
+}
+
+// hides the holidays of greetings, so the other parsers do not read "днём" of "с днём победы" as the day part
+func maskGreetings(in text, marker string) text {
+	matches := holidayRegex.FindAllStringSubmatchIndex(in.s, -1)
+	for i := len(matches) - 1; i >= 0; i-- {
+		idx := matches[i]
+		m := make([]string, len(idx)/2)
+		for j := range m {
+			if idx[2*j] >= 0 {
+				m[j] = in.s[idx[2*j]:idx[2*j+1]]
+			}
+		}
+		if isGreeting(m) {
+			in = in.replace(idx[6], idx[7], marker)
+		}
+	}
+	matches = holidayDateGreetingRegex.FindAllStringSubmatchIndex(in.s, -1)
+	for i := len(matches) - 1; i >= 0; i-- {
+		idx := matches[i]
+		day, _ := strconv.Atoi(in.s[idx[4]:idx[5]])
+		month, _ := parseMonth(in.s[idx[6]:idx[7]])
+		if isHolidayDate(month, day) {
+			in = in.replace(idx[2], idx[3], marker)
+		}
+	}
+	return in
+}
+
+// the holidays of a fixed date: their date is the same in any two years
+func isHolidayDate(month time.Month, day int) bool {
+	for h := holidayNewYear; h <= holidayThanksgiving; h++ {
+		date := holidayDate(h, 2001)
+		if date.Month() == month && date.Day() == day && holidayDate(h, 2002).YearDay() == date.YearDay() {
+			return true
+		}
+	}
+	return false
+}
+
+// "на Новый год", "на Пасху", "on Thanksgiving": a holiday passed in an earlier month is the next year's one
+func calculateHoliday(m []string, opts Opts) (time.Time, string) {
+	h := holiday(holidayLookup[m[3]])
+	date := holidayDate(h, dateYear(holidayDate(h, opts.Now.Year()).Month(), opts))
+	return getDate(date.Year(), date.Month(), date.Day(), opts.TodayEndHour, 0, 0, opts), m[0]
+}
+
+func holidayDate(h holiday, year int) time.Time {
+	day := func(month time.Month, day int) time.Time { return time.Date(year, month, day, 0, 0, 0, 0, time.UTC) }
+	switch h {
+	case holidayNewYearEve:
+		return day(time.December, 31)
+	case holidayOrthodoxChristmas:
+		return day(time.January, 7)
+	case holidayChristmas:
+		return day(time.December, 25)
+	case holidayChristmasEve:
+		return day(time.December, 24)
+	case holidayValentine:
+		return day(time.February, 14)
+	case holidayDefender:
+		return day(time.February, 23)
+	case holidayWomen:
+		return day(time.March, 8)
+	case holidayLabour:
+		return day(time.May, 1)
+	case holidayVictory:
+		return day(time.May, 9)
+	case holidayRussia:
+		return day(time.June, 12)
+	case holidayKnowledge:
+		return day(time.September, 1)
+	case holidayUnity:
+		return day(time.November, 4)
+	case holidayHalloween:
+		return day(time.October, 31)
+	case holidayIndependence:
+		return day(time.July, 4)
+	case holidayOrthodoxEaster:
+		return orthodoxEasterDate(year)
+	case holidayEaster:
+		return easterDate(year)
+	case holidayMaslenitsa:
+		// the week before the great lent, from monday
+		return orthodoxEasterDate(year).AddDate(0, 0, -55)
+	case holidayThanksgiving:
+		// the fourth thursday of november
+		first := day(time.November, 1)
+		return first.AddDate(0, 0, (int(time.Thursday)-int(first.Weekday())+7)%7+21)
+	}
+	return day(time.January, 1)
+}
+
+// gregorian computus, the anonymous algorithm
+func easterDate(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// julian computus moved to the gregorian calendar, the 13 days difference holds in 1900-2099
+func orthodoxEasterDate(year int) time.Time {
+	a, b, c := year%4, year%7, year%19
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+	month := (d + e + 114) / 31
+	day := (d+e+114)%31 + 1
+	return time.Date(year, time.Month(month), day+13, 0, 0, 0, 0, time.UTC)
+}
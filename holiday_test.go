@@ -0,0 +1,56 @@
+package dateparse
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHolidayDates(t *testing.T) {
+	for _, tt := range []struct {
+		holiday holiday
+		year    int
+		want    string
+	}{
+		{holidayEaster, 2019, "2019-04-21"},
+		{holidayEaster, 2024, "2024-03-31"},
+		{holidayEaster, 2038, "2038-04-25"},
+		{holidayOrthodoxEaster, 2021, "2021-05-02"},
+		{holidayOrthodoxEaster, 2024, "2024-05-05"},
+		{holidayOrthodoxEaster, 2025, "2025-04-20"},
+		{holidayMaslenitsa, 2020, "2020-02-24"},
+		{holidayMaslenitsa, 2026, "2026-02-16"},
+		{holidayThanksgiving, 2020, "2020-11-26"},
+		{holidayThanksgiving, 2024, "2024-11-28"},
+		{holidayWomen, 2030, "2030-03-08"},
+	} {
+		if got := holidayDate(tt.holiday, tt.year).Format("2006-01-02"); got != tt.want {
+			t.Errorf("%d in %d: got %s want %s", tt.holiday, tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestHolidayRolling(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// january 7 has passed in this month, it stays like "7 января" does
+	date, _ := Parse("на рождество", &Opts{Now: time.Date(2021, 1, 20, 12, 0, 0, 0, loc)})
+	if want := time.Date(2021, 1, 7, 18, 0, 0, 0, loc); !date.Equal(want) {
+		t.Errorf("got %s want %s", date, want)
+	}
+	date, _ = Parse("на пасху", &Opts{Now: time.Date(2021, 6, 1, 12, 0, 0, 0, loc)})
+	if want := time.Date(2022, 4, 24, 18, 0, 0, 0, loc); !date.Equal(want) {
+		t.Errorf("got %s want %s", date, want)
+	}
+}
+
+func TestHolidayDateGreeting(t *testing.T) {
+	now := time.Date(2021, 2, 1, 12, 0, 0, 0, time.UTC)
+	// "с 8 марта!" is a greeting, these are not
+	for _, input := range []string{"с 10 марта!", "с 8 марта в отпуске"} {
+		if date, _ := Parse(input, &Opts{Now: now}); date.IsZero() {
+			t.Errorf("%s: got no date", input)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package dateparse
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// the closest future is told as a duration
+const humanizeDurationLimit = 3 * time.Hour
+
+// Humanize tells t relative to opts.Now with the parser's own words, so Parse reads it back as t.
+func Humanize(t time.Time, opts *Opts) string {
+	var o Opts
+	if opts != nil {
+		o = *opts
+	}
+	if o.TodayEndHour == 0 {
+		o.TodayEndHour = 18
+	}
+	opts = &o
+	words := localeWords(opts.Locale)
+	now := opts.Now.Round(time.Second)
+	t = t.Round(time.Second).In(now.Location())
+
+	diff := t.Sub(now)
+	switch {
+	case diff == 0:
+		return words.Now
+	case diff < 0:
+		return fmt.Sprintf(words.Ago, durationSince(words, t, now))
+	case diff < humanizeDurationLimit:
+		return fmt.Sprintf(words.In, clockDuration(words, diff, true))
+	}
+
+	var day string
+	switch days := daysBetween(now, t); {
+	case days == 0:
+		day = words.Today
+	case days == 1:
+		day = words.Tomorrow
+	case days == 2:
+		day = words.AfterTomorrow
+	case days < 7:
+		day = words.Weekdays[t.Weekday()]
+	case t.Year() == now.Year() || t.Year() == now.Year()+1 && t.Month() < now.Month():
+		day = fmt.Sprintf(words.Date, t.Day(), words.Months[t.Month()-1])
+	default:
+		day = fmt.Sprintf(words.DateYear, t.Day(), words.Months[t.Month()-1], t.Year())
+	}
+	if t.Hour() == opts.TodayEndHour && t.Minute() == 0 && t.Second() == 0 {
+		return day
+	}
+	clock := t.Format("15:04")
+	if t.Second() != 0 {
+		clock = t.Format("15:04:05")
+	}
+	return day + " " + words.At + " " + clock
+}
+
+// FormatDuration renders d with the plural forms of the locale: "1 час 30 минут", "21 минута".
+func FormatDuration(d time.Duration, locale Locale) string {
+	if d < 0 {
+		d = -d
+	}
+	return dayDuration(localeWords(locale), d, false)
+}
+
+// FormatDurationIn renders d as an offset from now: "через 21 минуту", "in 2 hours".
+func FormatDurationIn(d time.Duration, locale Locale) string {
+	words := localeWords(locale)
+	if d < 0 {
+		return fmt.Sprintf(words.Ago, dayDuration(words, -d, true))
+	}
+	return fmt.Sprintf(words.In, dayDuration(words, d, true))
+}
+
+// whole days, then the clock duration of the rest
+func dayDuration(w LocaleWords, d time.Duration, accusative bool) string {
+	days := d / unitDurations[unitDay]
+	if days == 0 {
+		return clockDuration(w, d, accusative)
+	}
+	res := w.count(float64(days), unitDay, accusative)
+	if rest := d - days*unitDurations[unitDay]; rest >= time.Second {
+		res += " " + clockDuration(w, rest, accusative)
+	}
+	return res
+}
+
+// years, months and days are taken back on the calendar the same way the parser adds them
+func durationSince(w LocaleWords, t time.Time, now time.Time) string {
+	var parts []string
+	date := now
+	for _, unit := range []durationUnit{unitYear, unitMonth, unitDay} {
+		n := 0
+		for !addDuration(date, -float64(n+1), unit).Before(t) {
+			n++
+		}
+		if n > 0 {
+			date = addDuration(date, -float64(n), unit)
+			parts = append(parts, w.count(float64(n), unit, true))
+		}
+	}
+	if rest := date.Sub(t); rest > 0 {
+		parts = append(parts, clockDuration(w, rest, true))
+	}
+	return strings.Join(parts, " ")
+}
+
+func clockDuration(w LocaleWords, d time.Duration, accusative bool) string {
+	if d < time.Second {
+		return w.count(0, unitSecond, accusative)
+	}
+	var parts []string
+	for _, unit := range []durationUnit{unitHour, unitMinute, unitSecond} {
+		if n := d / unitDurations[unit]; n > 0 {
+			parts = append(parts, w.count(float64(n), unit, accusative))
+			d -= n * unitDurations[unit]
+		}
+	}
+	return strings.Join(parts, " ")
+}
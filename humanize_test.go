@@ -0,0 +1,108 @@
+package dateparse
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHumanize(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dt := time.Date(2020, 10, 10, 12, 1, 0, 0, loc)
+	for _, tt := range []struct {
+		date time.Time
+		ru   string
+		en   string
+	}{
+		{dt.Add(2*time.Hour + 5*time.Minute), "через 2 часа 5 минут", "in 2 hours 5 minutes"},
+		{dt.Add(time.Minute), "через 1 минуту", "in 1 minute"},
+		{time.Date(2020, 10, 10, 21, 30, 0, 0, loc), "сегодня в 21:30", "today at 21:30"},
+		{time.Date(2020, 10, 11, 10, 0, 0, 0, loc), "завтра в 10:00", "tomorrow at 10:00"},
+		{time.Date(2020, 10, 11, 18, 0, 0, 0, loc), "завтра", "tomorrow"},
+		{time.Date(2020, 10, 12, 9, 5, 30, 0, loc), "послезавтра в 09:05:30", "after tomorrow at 09:05:30"},
+		{time.Date(2020, 10, 16, 18, 0, 0, 0, loc), "в пятницу", "on friday"},
+		{time.Date(2020, 10, 13, 10, 0, 0, 0, loc), "во вторник в 10:00", "on tuesday at 10:00"},
+		{time.Date(2020, 11, 12, 10, 0, 0, 0, loc), "12 ноября в 10:00", "november 12 at 10:00"},
+		{time.Date(2021, 3, 5, 18, 0, 0, 0, loc), "5 марта", "march 5"},
+		{time.Date(2021, 10, 5, 18, 0, 0, 0, loc), "5 октября 2021", "october 5, 2021"},
+		{dt.AddDate(0, 0, -3), "3 дня назад", "3 days ago"},
+		{dt.Add(-90 * time.Minute), "1 час 30 минут назад", "1 hour 30 minutes ago"},
+		{time.Date(2019, 8, 31, 9, 0, 0, 0, loc), "1 год 1 месяц 10 дней 3 часа 1 минуту назад", "1 year 1 month 10 days 3 hours 1 minute ago"},
+		{dt, "сейчас", "now"},
+	} {
+		for locale, want := range map[Locale]string{LocaleRussian: tt.ru, LocaleEnglish: tt.en} {
+			opts := &Opts{Now: dt, Locale: locale}
+			got := Humanize(tt.date, opts)
+			if got != want {
+				t.Errorf("humanize %s: got '%s' want '%s'", tt.date, got, want)
+			}
+			if back, msg := Parse(got, opts); !back.Equal(tt.date) || msg != "" {
+				t.Errorf("parse '%s': got '%s' (comment: '%s') want '%s'", got, back, msg, tt.date)
+			}
+		}
+	}
+}
+
+func TestHumanizeKeepsOpts(t *testing.T) {
+	opts := &Opts{Now: time.Date(2020, 10, 10, 12, 1, 0, 0, time.UTC)}
+	Humanize(opts.Now.Add(48*time.Hour), opts)
+	if opts.TodayEndHour != 0 {
+		t.Errorf("opts changed: TodayEndHour %d", opts.TodayEndHour)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	for _, tt := range []struct {
+		dur    time.Duration
+		locale Locale
+		plain  string
+		in     string
+	}{
+		{time.Hour, LocaleRussian, "1 час", "через 1 час"},
+		{2 * time.Hour, LocaleRussian, "2 часа", "через 2 часа"},
+		{5 * time.Hour, LocaleRussian, "5 часов", "через 5 часов"},
+		{21 * time.Minute, LocaleRussian, "21 минута", "через 21 минуту"},
+		{11 * time.Minute, LocaleRussian, "11 минут", "через 11 минут"},
+		{22 * time.Second, LocaleRussian, "22 секунды", "через 22 секунды"},
+		{-3 * time.Minute, LocaleRussian, "3 минуты", "3 минуты назад"},
+		{49*time.Hour + 30*time.Minute, LocaleRussian, "2 дня 1 час 30 минут", "через 2 дня 1 час 30 минут"},
+		{45 * 24 * time.Hour, LocaleRussian, "45 дней", "через 45 дней"},
+		{time.Minute, LocaleEnglish, "1 minute", "in 1 minute"},
+		{25 * time.Hour, LocaleEnglish, "1 day 1 hour", "in 1 day 1 hour"},
+		{-time.Hour, LocaleEnglish, "1 hour", "1 hour ago"},
+		{0, LocaleEnglish, "0 seconds", "in 0 seconds"},
+	} {
+		if got := FormatDuration(tt.dur, tt.locale); got != tt.plain {
+			t.Errorf("FormatDuration(%s): got '%s' want '%s'", tt.dur, got, tt.plain)
+		}
+		if got := FormatDurationIn(tt.dur, tt.locale); got != tt.in {
+			t.Errorf("FormatDurationIn(%s): got '%s' want '%s'", tt.dur, got, tt.in)
+		}
+	}
+	for n, want := range map[float64]PluralCategory{1: PluralOne, 21: PluralOne, 11: PluralMany, 3: PluralFew, 14: PluralMany, 0: PluralMany, 1.5: PluralOther} {
+		if got := ruPlural(n); got != want {
+			t.Errorf("ruPlural(%v): got %d want %d", n, got, want)
+		}
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	RegisterLocale("test", LocaleWords{
+		In:     "in %s",
+		Plural: enPlural,
+		Units:  UnitWords{Minute: UnitForms{PluralOne: "min", PluralOther: "mins"}},
+	})
+	t.Cleanup(func() {
+		localesMu.Lock()
+		delete(locales, "test")
+		localesMu.Unlock()
+	})
+	if got := FormatDurationIn(2*time.Minute, "test"); got != "in 2 mins" {
+		t.Errorf("got '%s'", got)
+	}
+	if got := FormatDuration(time.Minute, "unknown"); got != "1 минута" {
+		t.Errorf("got '%s'", got)
+	}
+}
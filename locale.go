@@ -0,0 +1,210 @@
+package dateparse
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type Locale string
+
+const (
+	LocaleRussian Locale = "ru"
+	LocaleEnglish Locale = "en"
+)
+
+// CLDR plural categories
+type PluralCategory int
+
+const (
+	PluralOther PluralCategory = iota
+	PluralOne
+	PluralFew
+	PluralMany
+)
+
+// UnitForms maps plural categories to the word forms of a unit
+type UnitForms map[PluralCategory]string
+
+// UnitWords are the word forms of every duration unit
+type UnitWords struct {
+	Second, Minute, Hour, Day, Week, Month, Year UnitForms
+}
+
+// LocaleWords is the vocabulary Humanize and FormatDuration speak.
+type LocaleWords struct {
+	In, Ago                        string // "через %s", "%s назад"
+	Now                            string
+	Today, Tomorrow, AfterTomorrow string
+	At                             string
+	Weekdays                       [7]string // sunday first, with a preposition: "в пятницу"
+	WeekdayNames                   [7]string // sunday first: "пятница"
+	Seasons                        [4]string // spring first
+	Months                         [12]string
+	Date, DateYear                 string // day, month name and year as fmt arguments
+	Year                           string // a year as a fmt argument: "%d год"
+	Plural                         func(n float64) PluralCategory
+	Units                          UnitWords
+	UnitsAccusative                UnitWords // after "через" and before "назад", Units when empty
+}
+
+var localesMu sync.RWMutex
+
+var locales = map[Locale]LocaleWords{
+	LocaleRussian: {
+		In:            "через %s",
+		Ago:           "%s назад",
+		Now:           "сейчас",
+		Today:         "сегодня",
+		Tomorrow:      "завтра",
+		AfterTomorrow: "послезавтра",
+		At:            "в",
+		Weekdays:      [7]string{"в воскресенье", "в понедельник", "во вторник", "в среду", "в четверг", "в пятницу", "в субботу"},
+		WeekdayNames:  [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+		Seasons:       [4]string{"весна", "лето", "осень", "зима"},
+		Months: [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября",
+			"ноября", "декабря"},
+		Date:     "%[1]d %[2]s",
+		DateYear: "%[1]d %[2]s %[3]d",
+		Year:     "%d год",
+		Plural:   ruPlural,
+		Units: UnitWords{
+			Second: ruUnitForms(ruSecondForms),
+			Minute: ruUnitForms(ruMinuteForms),
+			Hour:   ruUnitForms(ruHourForms),
+			Day:    ruUnitForms(ruDayForms),
+			Week:   ruUnitForms(ruWeekForms),
+			Month:  ruUnitForms(ruMonthForms),
+			Year:   ruUnitForms(ruYearForms),
+		},
+		// only the feminine units have an accusative of their own
+		UnitsAccusative: UnitWords{
+			Second: ruAccusativeForms(ruSecondForms),
+			Minute: ruAccusativeForms(ruMinuteForms),
+			Week:   ruAccusativeForms(ruWeekForms),
+		},
+	},
+	LocaleEnglish: {
+		In:            "in %s",
+		Ago:           "%s ago",
+		Now:           "now",
+		Today:         "today",
+		Tomorrow:      "tomorrow",
+		AfterTomorrow: "after tomorrow",
+		At:            "at",
+		Weekdays:      [7]string{"on sunday", "on monday", "on tuesday", "on wednesday", "on thursday", "on friday", "on saturday"},
+		WeekdayNames:  [7]string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"},
+		Seasons:       [4]string{"spring", "summer", "autumn", "winter"},
+		Months: [12]string{"january", "february", "march", "april", "may", "june", "july", "august", "september", "october",
+			"november", "december"},
+		Date:     "%[2]s %[1]d",
+		DateYear: "%[2]s %[1]d, %[3]d",
+		Year:     "in %d",
+		Plural:   enPlural,
+		Units: UnitWords{
+			Second: enUnitForms(enSecondForms),
+			Minute: enUnitForms(enMinuteForms),
+			Hour:   enUnitForms(enHourForms),
+			Day:    enUnitForms(enDayForms),
+			Week:   enUnitForms(enWeekForms),
+			Month:  enUnitForms(enMonthForms),
+			Year:   enUnitForms(enYearForms),
+		},
+	},
+}
+
+// RegisterLocale adds or replaces a locale, it is safe to call while other goroutines format dates.
+func RegisterLocale(locale Locale, words LocaleWords) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[locale] = words
+}
+
+// russian is the default
+func localeWords(locale Locale) LocaleWords {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	if words, ok := locales[locale]; ok {
+		return words
+	}
+	return locales[LocaleRussian]
+}
+
+// 1 час, 2 часа, 5 часов, 1.5 часа
+func ruPlural(n float64) PluralCategory {
+	if n != math.Trunc(n) {
+		return PluralOther
+	}
+	i := int64(math.Abs(n))
+	switch {
+	case i%10 == 1 && i%100 != 11:
+		return PluralOne
+	case i%10 >= 2 && i%10 <= 4 && (i%100 < 12 || i%100 > 14):
+		return PluralFew
+	}
+	return PluralMany
+}
+
+func enPlural(n float64) PluralCategory {
+	if n == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// the forms of a noun declined by a paradigm of morph.go: the genitive singular is the second one,
+// the genitive plural the seventh
+func ruUnitForms(forms string) UnitForms {
+	f := strings.Split(forms, "|")
+	return UnitForms{PluralOne: f[0], PluralFew: f[1], PluralMany: f[6], PluralOther: f[1]}
+}
+
+// the accusative singular of a feminine noun is the fourth form: "минуту"
+func ruAccusativeForms(forms string) UnitForms {
+	return UnitForms{PluralOne: strings.Split(forms, "|")[3]}
+}
+
+func enUnitForms(forms string) UnitForms {
+	f := strings.Split(forms, "|")
+	return UnitForms{PluralOne: f[0], PluralOther: f[1]}
+}
+
+func (w LocaleWords) count(n float64, unit durationUnit, accusative bool) string {
+	category := PluralOther
+	if w.Plural != nil {
+		category = w.Plural(n)
+	}
+	word, ok := w.UnitsAccusative.forms(unit)[category]
+	if !accusative || !ok {
+		word = w.Units.forms(unit)[category]
+	}
+	if word == "" {
+		word = w.Units.forms(unit)[PluralOther]
+	}
+	return formatNumber(n) + " " + word
+}
+
+func (w UnitWords) forms(unit durationUnit) UnitForms {
+	switch unit {
+	case unitSecond:
+		return w.Second
+	case unitMinute:
+		return w.Minute
+	case unitHour:
+		return w.Hour
+	case unitDay:
+		return w.Day
+	case unitWeek:
+		return w.Week
+	case unitMonth:
+		return w.Month
+	case unitYear:
+		return w.Year
+	}
+	return nil
+}
+
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
@@ -1,52 +1,40 @@
 package dateparse
 
-import (
-	"strings"
-	"time"
-)
+import "time"
 
 var (
-	january   = `января|янв|january|jan`
-	february  = `февраля|фев|february|feb`
-	march     = `марта|мар|march|mar`
-	april     = `апреля|апрель|апр|april|apr`
-	may       = `мая|май|may`
-	june      = `июня|июнь|june|jun`
-	july      = `июля|июль|july|jul`
-	august    = `августа|август|august|aug`
-	september = `сентября|сентябрь|сент|september|sep`
-	october   = `октября|октябрь|october|oct`
-	november  = `ноября|ноябрь|november|nov`
-	december  = `декабря|дек|december|dec`
-	months    = strings.Join([]string{january, february, march, april, may, june, july, august, september, october, november, december}, "|")
+	january   = alternation(ruForms("январ", mascSoft), `янв|january|jan`)
+	february  = alternation(ruForms("феврал", mascSoft), `фев|february|feb`)
+	march     = alternation(ruForms("март", mascHard), `мар|march|mar`)
+	april     = alternation(ruForms("апрел", mascSoft), `апр|april|apr`)
+	may       = alternation(ruForms("ма", mascJ), `may`)
+	june      = alternation(ruForms("июн", mascSoft), `june|jun`)
+	july      = alternation(ruForms("июл", mascSoft), `july|jul`)
+	august    = alternation(ruForms("август", mascHard), `авг|august|aug`)
+	september = alternation(ruForms("сентябр", mascSoft), `сент|сен|september|sept|sep`)
+	october   = alternation(ruForms("октябр", mascSoft), `окт|october|oct`)
+	november  = alternation(ruForms("ноябр", mascSoft), `ноя|november|nov`)
+	december  = alternation(ruForms("декабр", mascSoft), `дек|december|dec`)
+	months    = alternation(january, february, march, april, may, june, july, august, september, october, november, december)
+	enMonths  = latinWords(months)
 )
 
-func parseMonth(monthStr string) time.Month {
-	switch {
-	case strings.Contains(january, monthStr):
-		return 1
-	case strings.Contains(february, monthStr):
-		return 2
-	case strings.Contains(march, monthStr):
-		return 3
-	case strings.Contains(april, monthStr):
-		return 4
-	case strings.Contains(may, monthStr):
-		return 5
-	case strings.Contains(june, monthStr):
-		return 6
-	case strings.Contains(july, monthStr):
-		return 7
-	case strings.Contains(august, monthStr):
-		return 8
-	case strings.Contains(september, monthStr):
-		return 9
-	case strings.Contains(october, monthStr):
-		return 10
-	case strings.Contains(november, monthStr):
-		return 11
-	case strings.Contains(december, monthStr):
-		return 12
-	}
-	return 0
+var monthLookup = makeLookup(map[int]string{
+	int(time.January):   january,
+	int(time.February):  february,
+	int(time.March):     march,
+	int(time.April):     april,
+	int(time.May):       may,
+	int(time.June):      june,
+	int(time.July):      july,
+	int(time.August):    august,
+	int(time.September): september,
+	int(time.October):   october,
+	int(time.November):  november,
+	int(time.December):  december,
+})
+
+func parseMonth(monthStr string) (time.Month, bool) {
+	m, ok := monthLookup[monthStr]
+	return time.Month(m), ok
 }
@@ -0,0 +1,65 @@
+package dateparse
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// russian endings by declension paradigm: singular cases first, then plural
+var (
+	mascHard  = []string{"", "а", "у", "ом", "е", "ы", "ов", "ам", "ами", "ах"}                           // час, март
+	mascVelar = []string{"", "а", "у", "ом", "е", "и", "ов", "ам", "ами", "ах"}                           // понедельник, четверг
+	mascTs    = []string{"", "а", "у", "ем", "е", "ы", "ев", "ам", "ами", "ах"}                           // месяц
+	mascSoft  = []string{"ь", "я", "ю", "ем", "ём", "е", "и", "ей", "ям", "ями", "ях"}                    // январь
+	mascJ     = []string{"й", "я", "ю", "ем", "е", "и", "ев", "ям", "ями", "ях"}                          // май
+	femHard   = []string{"а", "ы", "е", "у", "ой", "ою", "", "ам", "ами", "ах"}                           // минута, суббота
+	femVelar  = []string{"а", "и", "е", "у", "ой", "ою", "ам", "ами", "ах"}                               // минутка
+	femTs     = []string{"а", "ы", "е", "у", "ей", "ею", "", "ам", "ами", "ах"}                           // пятница
+	femSoft   = []string{"я", "и", "е", "ю", "ей", "ею", "ь", "ям", "ями", "ях"}                          // неделя
+	neutHard  = []string{"о", "а", "у", "ом", "е"}                                                        // утро
+	neutSoft  = []string{"ье", "ья", "ью", "ьем", "ий", "ьям", "ьями", "ьях"}                             // воскресенье
+	adjHard   = []string{"ый", "ая", "ое", "ые", "ого", "ой", "ому", "ую", "ым", "ом", "ых", "ыми", "ою"} // прошлый
+	adjSoft   = []string{"ий", "ая", "ее", "ие", "его", "ей", "ему", "ую", "им", "ем", "их", "ими", "ею"} // следующий
+)
+
+func ruForms(stem string, endings []string) string {
+	forms := make([]string, 0, len(endings))
+	for _, e := range endings {
+		forms = append(forms, stem+e)
+	}
+	return strings.Join(forms, "|")
+}
+
+// joins alternatives longest first, so regexp alternation never stops at a shorter prefix
+func alternation(patterns ...string) string {
+	seen := make(map[string]bool)
+	var words []string
+	for _, p := range patterns {
+		for _, w := range strings.Split(p, "|") {
+			if w != "" && !seen[w] {
+				seen[w] = true
+				words = append(words, w)
+			}
+		}
+	}
+	sort.SliceStable(words, func(i, j int) bool {
+		return utf8.RuneCountInString(words[i]) > utf8.RuneCountInString(words[j])
+	})
+	return strings.Join(words, "|")
+}
+
+var asciiWord = regexp.MustCompile(`^[a-z][a-z.' ]*$`)
+
+// quotes the words of an alternation and puts the latin ones between \b, regexp \b only knows ascii letters
+func boundedWords(p string) string {
+	words := strings.Split(p, "|")
+	for i, w := range words {
+		words[i] = regexp.QuoteMeta(w)
+		if asciiWord.MatchString(w) {
+			words[i] = `\b` + words[i] + `\b`
+		}
+	}
+	return strings.Join(words, "|")
+}
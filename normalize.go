@@ -0,0 +1,97 @@
+package dateparse
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Normalize replaces the date found in s with its canonical form and keeps the rest of the text:
+// "в пн утром созвон" -> "понедельник 10:00 созвон", "05/26/22" -> "2022-05-26".
+func Normalize(s string, opts *Opts) string {
+	var o Opts
+	if opts != nil {
+		o = *opts
+	}
+	if o.TodayEndHour == 0 {
+		o.TodayEndHour = 18
+	}
+	res, spans := parse(s, &o)
+	if res.Date.IsZero() || len(spans) == 0 {
+		return s
+	}
+	canonical := canonicalDate(res, spans, o)
+
+	spans = append([]span(nil), spans...)
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	var b strings.Builder
+	b.WriteString(s[:spans[0].start])
+	b.WriteString(canonical)
+	pos := spans[0].end
+	for _, sp := range spans[1:] {
+		if sp.start < pos {
+			continue
+		}
+		b.WriteString(s[pos:sp.start])
+		pos = sp.end
+		// a removed span leaves one space, not two
+		if strings.HasSuffix(b.String(), " ") && strings.HasPrefix(s[pos:], " ") {
+			pos++
+		}
+	}
+	b.WriteString(s[pos:])
+	return strings.TrimSpace(b.String())
+}
+
+func canonicalDate(res Result, spans []span, opts Opts) string {
+	words := localeWords(opts.Locale)
+	t := res.Date
+	switch res.Granularity {
+	case GranularityYear:
+		if words.Year != "" {
+			return fmt.Sprintf(words.Year, t.Year())
+		}
+		return t.Format("2006")
+	case GranularityMonth:
+		return t.Format("2006-01")
+	case GranularitySeason:
+		// december opens the winter of its year
+		season := (int(t.Month())%12)/3 - 1
+		year := t.Year()
+		if season < 0 {
+			season = 3
+			if t.Month() != time.December {
+				year--
+			}
+		}
+		if name := words.Seasons[season]; name != "" {
+			return name + " " + strconv.Itoa(year)
+		}
+		return t.Format("2006-01")
+	}
+
+	day := t.Format("2006-01-02")
+	if name := words.WeekdayNames[t.Weekday()]; name != "" && mentionsWeekday(spans) && daysBetween(opts.Now, t) < 7 {
+		day = name
+	}
+	if t.Hour() == opts.TodayEndHour && t.Minute() == 0 && t.Second() == 0 {
+		return day
+	}
+	if t.Second() != 0 {
+		return day + " " + t.Format("15:04:05")
+	}
+	return day + " " + t.Format("15:04")
+}
+
+func mentionsWeekday(spans []span) bool {
+	for _, sp := range spans {
+		for _, word := range strings.Fields(sp.text) {
+			if _, ok := parseWeekDays(word); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package dateparse
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNormalize(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dt := time.Date(2020, 10, 10, 12, 1, 0, 0, loc)
+	for _, tt := range []struct {
+		input  string
+		locale Locale
+		want   string
+	}{
+		{"в пн утром", LocaleRussian, "понедельник 10:00"},
+		{"в пн утром созвон", LocaleEnglish, "monday 10:00 созвон"},
+		{"05/26/22", LocaleRussian, "2022-05-26"},
+		{"Отчёт 05/26/22 сдать", LocaleRussian, "Отчёт 2022-05-26 сдать"},
+		{"Завтра в 10:30 купить хлеб", LocaleRussian, "2020-10-11 10:30 купить хлеб"},
+		{"в пятницу в 18:00 пиво", LocaleRussian, "пятница пиво"},
+		{"летом поехать на дачу", LocaleRussian, "лето 2021 поехать на дачу"},
+		{"прошлой зимой", LocaleEnglish, "winter 2019"},
+		{"в марте", LocaleRussian, "2021-03"},
+		{"в 2027 году", LocaleRussian, "2027 год"},
+		{"in 2027", LocaleEnglish, "in 2027"},
+		{"ЗАВТРА в 10:30 Купить ХЛЕБ", LocaleRussian, "2020-10-11 10:30 Купить ХЛЕБ"},
+		{"звтра позвонить", LocaleRussian, "2020-10-11 позвонить"},
+		{"deploy 2024-03-05T07:00:00Z done", LocaleRussian, "deploy 2024-03-05 10:00 done"},
+		{"nothing here", LocaleRussian, "nothing here"},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			opts := &Opts{Now: dt, Locale: tt.locale}
+			got := Normalize(tt.input, opts)
+			if got != tt.want {
+				t.Errorf("got '%s' want '%s'", got, tt.want)
+			}
+			// the canonical form reads back as the same date
+			want, _ := Parse(tt.input, opts)
+			if back, _ := Parse(got, opts); !back.Equal(want) {
+				t.Errorf("parse '%s': got '%s' want '%s'", got, back, want)
+			}
+		})
+	}
+}
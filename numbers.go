@@ -0,0 +1,198 @@
+package dateparse
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	ruUnits = []string{
+		`ноль|нуль|ноля|нуля`,
+		`один|одна|одно|одну|одного|одной|одному|одним|одном|одною`,
+		`два|две|двух|двум|двумя`,
+		`три|трёх|трех|трём|трем|тремя`,
+		`четыре|четырёх|четырех|четырём|четырем|четырьмя`,
+		`пять|пяти|пятью`,
+		`шесть|шести|шестью`,
+		`семь|семи|семью`,
+		`восемь|восьми|восемью|восьмью`,
+		`девять|девяти|девятью`,
+	}
+	ruTeens = []string{
+		ruForms("десят", numEndings),
+		ruForms("одиннадцат", numEndings),
+		ruForms("двенадцат", numEndings),
+		ruForms("тринадцат", numEndings),
+		ruForms("четырнадцат", numEndings),
+		ruForms("пятнадцат", numEndings),
+		ruForms("шестнадцат", numEndings),
+		ruForms("семнадцат", numEndings),
+		ruForms("восемнадцат", numEndings),
+		ruForms("девятнадцат", numEndings),
+	}
+	ruTens = []string{
+		``,
+		``,
+		ruForms("двадцат", numEndings),
+		ruForms("тридцат", numEndings),
+		`сорок|сорока`,
+		`пятьдесят|пятидесяти|пятьюдесятью`,
+		`шестьдесят|шестидесяти|шестьюдесятью`,
+		`семьдесят|семидесяти|семьюдесятью`,
+		`восемьдесят|восьмидесяти|восемьюдесятью`,
+		`девяносто|девяноста`,
+	}
+	ruHundreds = []string{
+		``,
+		`сто|ста|сотню`,
+		`двести|двухсот|двумстам|двумястами|двухстах`,
+		`триста|трёхсот|трехсот|трёмстам|тремстам|тремястами|трёхстах|трехстах`,
+		`четыреста|четырёхсот|четырехсот|четырёмстам|четыремстам|четырьмястами|четырёхстах|четырехстах`,
+		`пятьсот|пятисот|пятистам|пятьюстами|пятистах`,
+		`шестьсот|шестисот|шестистам|шестьюстами|шестистах`,
+		`семьсот|семисот|семистам|семьюстами|семистах`,
+		`восемьсот|восьмисот|восьмистам|восемьюстами|восьмистах`,
+		`девятьсот|девятисот|девятистам|девятьюстами|девятистах`,
+	}
+	numEndings = []string{"ь", "и", "ью"}
+)
+
+var (
+	enUnits    = []string{`zero`, `one`, `two`, `three`, `four`, `five`, `six`, `seven`, `eight`, `nine`}
+	enTeens    = []string{`ten`, `eleven`, `twelve`, `thirteen`, `fourteen`, `fifteen`, `sixteen`, `seventeen`, `eighteen`, `nineteen`}
+	enTens     = []string{``, ``, `twenty`, `thirty`, `forty`, `fifty`, `sixty`, `seventy`, `eighty`, `ninety`}
+	enHundreds = enHundredForms()
+)
+
+var (
+	quarter   = `quarter|четверть|четверти`
+	half      = `half|half a|half an|a half|пол|половина|половину|половины`
+	oneHalf   = `полтора|полторы|полутора`
+	couple    = `a couple of|a couple|couple of|couple|пара|пару|пары|парочку`
+	few       = `a few|few|несколько|нескольких`
+	dozen     = `a dozen|dozen|дюжина|дюжину`
+	article   = `an|a`
+	fractions = []string{quarter, half, oneHalf, couple, few, dozen, article}
+)
+
+var (
+	unitNumbers    = alternation(strings.Join(ruUnits[1:], "|"), strings.Join(enUnits[1:], "|"))
+	teenNumbers    = alternation(strings.Join(ruTeens, "|"), strings.Join(enTeens, "|"))
+	tenNumbers     = alternation(strings.Join(ruTens, "|"), strings.Join(enTens, "|"))
+	hundredNumbers = alternation(strings.Join(ruHundreds, "|"), enHundreds)
+
+	// plain vocabulary, for word sets
+	numberWords = alternation(strings.Join(ruUnits, "|"), strings.Join(enUnits, "|"), teenNumbers, tenNumbers, hundredNumbers,
+		strings.Join(fractions, "|"))
+
+	tensAndUnits = fmt.Sprintf(`(?:%s)|(?:%s)(?:[ -](?:%s))?|(?:%s)`, teenNumbers, tenNumbers, unitNumbers, unitNumbers)
+	cardinal     = fmt.Sprintf(`(?:%s)(?:[ -](?:%s))?|%s`, hundredNumbers, tensAndUnits, tensAndUnits)
+
+	// cardinal numbers and fractions, without a bare article
+	strictWordNumbers = fmt.Sprintf(`%s|%s`, cardinal, alternation(quarter, half, oneHalf, couple, few, dozen))
+	wordNumbers       = fmt.Sprintf(`%s|%s`, strictWordNumbers, article)
+)
+
+var numberValues = makeNumberValues()
+
+func enHundredForms() string {
+	forms := []string{"hundred", "a hundred"}
+	for _, u := range enUnits[1:] {
+		forms = append(forms, u+" hundred")
+	}
+	return alternation(strings.Join(forms, "|"))
+}
+
+func makeNumberValues() map[string]float64 {
+	res := make(map[string]float64)
+	add := func(patterns []string, k float64) {
+		for i, p := range patterns {
+			for _, w := range splitAlternation(p) {
+				res[w] = float64(i) * k
+			}
+		}
+	}
+	add(ruUnits, 1)
+	add(enUnits, 1)
+	add(ruTens, 10)
+	add(enTens, 10)
+	add(ruHundreds, 100)
+	for i, p := range ruTeens {
+		for _, w := range splitAlternation(p) {
+			res[w] = float64(10 + i)
+		}
+	}
+	for i, w := range enTeens {
+		res[w] = float64(10 + i)
+	}
+	for _, v := range []struct {
+		pattern string
+		value   float64
+	}{
+		{quarter, 0.25},
+		{half, 0.5},
+		{oneHalf, 1.5},
+		{couple, 2},
+		{few, 3},
+		{dozen, 12},
+		{article, 1},
+	} {
+		for _, w := range splitAlternation(v.pattern) {
+			res[w] = v.value
+		}
+	}
+	return res
+}
+
+// parses spelled cardinal numbers up to 999: "двадцать пять", "twenty-one", "a couple of"
+func parseCardinal(s string) (float64, bool) {
+	s = strings.TrimSpace(s)
+	if v, ok := numberValues[s]; ok {
+		return v, true
+	}
+	tokens := strings.FieldsFunc(s, func(r rune) bool { return r == ' ' || r == '-' })
+	if len(tokens) == 0 {
+		return 0, false
+	}
+	// every next word is of a smaller magnitude: "сто сто" and "десять пять" are not numbers
+	var total float64
+	limit := 1000.0
+	for i, tok := range tokens {
+		switch {
+		case tok == "hundred":
+			if total >= 10 {
+				return 0, false
+			}
+			if total == 0 {
+				total = 1
+			}
+			total *= 100
+			limit = 100
+		case tok == "and":
+		case (tok == "a" || tok == "an") && i+1 < len(tokens):
+		default:
+			v, ok := numberValues[tok]
+			if !ok || v <= 0 || v >= limit || v != float64(int(v)) {
+				return 0, false
+			}
+			total += v
+			switch {
+			case v >= 100:
+				limit = 100
+			case v >= 20:
+				limit = 10
+			default:
+				limit = 1
+			}
+		}
+	}
+	return total, true
+}
+
+func forceNumber(s string) int {
+	if v := forceInt(s); v != 0 {
+		return v
+	}
+	v, _ := parseCardinal(s)
+	return int(v)
+}
@@ -0,0 +1,90 @@
+package dateparse
+
+import (
+	"sort"
+	"strings"
+)
+
+var (
+	ordHard     = []string{"ый", "ое", "ого", "ому", "ым", "ом", "ая", "ой", "ую", "ые", "ых"}       // первый
+	ordStressed = []string{"ой", "ое", "ого", "ому", "ым", "ом", "ая", "ую", "ые", "ых"}             // второй
+	ordSoft     = []string{"ий", "ье", "ьего", "ьему", "ьим", "ьем", "ья", "ьей", "ью", "ьи", "ьих"} // третий
+)
+
+var (
+	ruOrdinals = []string{
+		``,
+		ruForms("перв", ordHard),
+		ruForms("втор", ordStressed),
+		ruForms("трет", ordSoft),
+		alternation(ruForms("четверт", ordHard), ruForms("четвёрт", ordHard)),
+		ruForms("пят", ordHard),
+		ruForms("шест", ordStressed),
+		ruForms("седьм", ordStressed),
+		ruForms("восьм", ordStressed),
+		ruForms("девят", ordHard),
+		ruForms("десят", ordHard),
+		ruForms("одиннадцат", ordHard),
+		ruForms("двенадцат", ordHard),
+		ruForms("тринадцат", ordHard),
+		ruForms("четырнадцат", ordHard),
+		ruForms("пятнадцат", ordHard),
+		ruForms("шестнадцат", ordHard),
+		ruForms("семнадцат", ordHard),
+		ruForms("восемнадцат", ordHard),
+		ruForms("девятнадцат", ordHard),
+		ruForms("двадцат", ordHard),
+	}
+	enOrdinals = []string{
+		``, `first`, `second`, `third`, `fourth`, `fifth`, `sixth`, `seventh`, `eighth`, `ninth`, `tenth`,
+		`eleventh`, `twelfth`, `thirteenth`, `fourteenth`, `fifteenth`, `sixteenth`, `seventeenth`, `eighteenth`, `nineteenth`,
+		`twentieth`,
+	}
+)
+
+var (
+	ordinalLookup = makeOrdinalLookup()
+	ordinalDays   = makeOrdinalDays()
+)
+
+func makeOrdinalLookup() map[string]int {
+	res := make(map[string]int)
+	add := func(ordinals []string, tens map[int]string, sep []string) {
+		for day, p := range ordinals {
+			for _, w := range splitAlternation(p) {
+				res[w] = day
+				for ten, prefix := range tens {
+					if day < 10 && (ten < 30 || day <= 1) {
+						for _, s := range sep {
+							res[prefix+s+w] = ten + day
+						}
+					}
+				}
+			}
+		}
+	}
+	add(ruOrdinals, map[int]string{20: "двадцать", 30: "тридцать"}, []string{" "})
+	add(enOrdinals, map[int]string{20: "twenty", 30: "thirty"}, []string{" ", "-"})
+	for _, w := range splitAlternation(ruForms("тридцат", ordHard)) {
+		res[w] = 30
+	}
+	res["thirtieth"] = 30
+	return res
+}
+
+func makeOrdinalDays() string {
+	words := make([]string, 0, len(ordinalLookup))
+	for w := range ordinalLookup {
+		words = append(words, w)
+	}
+	sort.Strings(words)
+	return alternation(strings.Join(words, "|"))
+}
+
+// day of month written with digits or as an ordinal word
+func parseDay(s string) int {
+	if day, ok := ordinalLookup[s]; ok {
+		return day
+	}
+	return forceInt(s)
+}
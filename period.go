@@ -0,0 +1,156 @@
+package dateparse
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+type Anchor int
+
+const (
+	AnchorStart Anchor = iota
+	AnchorMiddle
+	AnchorEnd
+)
+
+var (
+	spring  = alternation(`весной|весна|весну|spring`)
+	summer  = alternation(`летом|лето|summer`)
+	autumn  = alternation(`осенью|осень|autumn|fall`)
+	winter  = alternation(`зимой|зима|зиму|winter`)
+	seasons = alternation(spring, summer, autumn, winter)
+
+	periodPrefix   = `(?:(?:в|во|на|in|during)[" "](?:the[" "])?)`
+	periodModifier = alternation(thisWords, nextWords, lastWords)
+)
+
+var (
+	// the first month of the season
+	seasonLookup = makeLookup(map[int]string{
+		int(time.March):     spring,
+		int(time.June):      summer,
+		int(time.September): autumn,
+		int(time.December):  winter,
+	})
+	seasonAdverbs = makeSet(`весной|летом|осенью|зимой`)
+	// periods from the current one
+	modifierLookup = makeLookup(map[int]string{
+		0:  thisWords,
+		1:  nextWords,
+		-1: lastWords,
+	})
+)
+
+var (
+	monthPeriodRegex    = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(%s)?(?:(%s)[" "])?(%s)(?:[" "]([1-9]\d\d\d))?(?:[" "]|$|[.,])`, periodPrefix, periodModifier, months))
+	seasonRegex         = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(%s)?(?:(%s)[" "])?(%s)(?:[" "]([1-9]\d\d\d))?(?:[" "]|$|[.,])`, periodPrefix, periodModifier, seasons))
+	isoMonthRegex       = regexp.MustCompile(`(?:^|[" "])([1-9]\d\d\d)-(0[1-9]|1[0-2])(?:[" "]|$|[.,])`)
+	relativePeriodRegex = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])%s?(%s)[" "](%s|%s)(?:[" "]|$|[.,])`, periodPrefix, periodModifier, monthsWords, years))
+)
+
+// a bare month name is too ambiguous: "may", "mar"; "in may 5th" names a day
+func hasMonthPeriod(s string) bool {
+	if monthddRegex.MatchString(s) || monthOrdinalRegex.MatchString(s) {
+		return false
+	}
+	m := monthPeriodRegex.FindStringSubmatch(s)
+	return m != nil && (m[1] != "" || m[2] != "")
+}
+
+// "в марте", "next march", "в мае 2027"
+func calculateMonthPeriod(m []string, opts Opts) (time.Time, string) {
+	month, _ := parseMonth(m[3])
+	year := opts.Now.Year()
+	switch {
+	case m[4] != "":
+		year = forceInt(m[4])
+	case periodShift(m[2]) > 0:
+		if month <= opts.Now.Month() {
+			year++
+		}
+	case periodShift(m[2]) < 0:
+		if month >= opts.Now.Month() {
+			year--
+		}
+	case month < opts.Now.Month():
+		year++
+	}
+	start := getDate(year, month, 1, 0, 0, 0, opts)
+	return anchorPeriod(start, start.AddDate(0, 1, 0), opts), m[0]
+}
+
+// "2021-03", the canonical form of a month
+func calculateIsoMonth(m []string, opts Opts) (time.Time, string) {
+	start := getDate(forceInt(m[1]), time.Month(forceInt(m[2])), 1, 0, 0, 0, opts)
+	return anchorPeriod(start, start.AddDate(0, 1, 0), opts), m[0]
+}
+
+// "летом" and "лето 2021" are clear, "лето" and "fall" alone are not
+func hasSeasonPeriod(s string) bool {
+	m := seasonRegex.FindStringSubmatch(s)
+	return m != nil && (m[1] != "" || m[2] != "" || m[4] != "" || seasonAdverbs[m[3]])
+}
+
+// "летом", "следующей зимой", "this autumn"
+func calculateSeasonPeriod(m []string, opts Opts) (time.Time, string) {
+	// the season of a winter date starts in december
+	current := getDate(opts.Now.Year(), opts.Now.Month()-opts.Now.Month()%3, 1, 0, 0, 0, opts)
+	start := getDate(current.Year(), time.Month(seasonLookup[m[3]]), 1, 0, 0, 0, opts)
+	if start.Before(current) {
+		start = start.AddDate(1, 0, 0)
+	}
+	switch shift := periodShift(m[2]); {
+	case m[4] != "":
+		start = getDate(forceInt(m[4]), start.Month(), 1, 0, 0, 0, opts)
+	case shift > 0 && start.Equal(current):
+		start = start.AddDate(1, 0, 0)
+	case shift < 0:
+		start = start.AddDate(-1, 0, 0)
+	}
+	return anchorPeriod(start, start.AddDate(0, 3, 0), opts), m[0]
+}
+
+// "в следующем месяце", "в этом году", "last year"
+func calculateRelativePeriod(m []string, opts Opts) (time.Time, string) {
+	shift := periodShift(m[1])
+	if parseUnit(m[2]) == unitYear {
+		start := getDate(opts.Now.Year()+shift, time.January, 1, 0, 0, 0, opts)
+		return anchorPeriod(start, start.AddDate(1, 0, 0), opts), m[0]
+	}
+	start := getDate(opts.Now.Year(), opts.Now.Month()+time.Month(shift), 1, 0, 0, 0, opts)
+	return anchorPeriod(start, start.AddDate(0, 1, 0), opts), m[0]
+}
+
+func periodShift(s string) int { return modifierLookup[s] }
+
+// picks a day of the [start, end) period according to opts.PeriodAnchor
+func anchorPeriod(start time.Time, end time.Time, opts Opts) time.Time {
+	day := start
+	switch opts.PeriodAnchor {
+	case AnchorMiddle:
+		days := daysBetween(start, end)
+		day = start.AddDate(0, 0, days/2)
+	case AnchorEnd:
+		day = end.AddDate(0, 0, -1)
+	}
+	return getDate(day.Year(), day.Month(), day.Day(), opts.TodayEndHour, 0, 0, opts)
+}
+
+// how precise the matched date is, a bare year or month is not a day
+func dateGranularity(s string) Granularity {
+	switch {
+	case matchesWhole(yearRegex, s), matchesWhole(enYearRegex, s):
+		return GranularityYear
+	case matchesWhole(monthPeriodRegex, s) && hasMonthPeriod(s), matchesWhole(isoMonthRegex, s):
+		return GranularityMonth
+	case matchesWhole(seasonRegex, s) && hasSeasonPeriod(s):
+		return GranularitySeason
+	case matchesWhole(relativePeriodRegex, s):
+		if parseUnit(relativePeriodRegex.FindStringSubmatch(s)[2]) == unitYear {
+			return GranularityYear
+		}
+		return GranularityMonth
+	}
+	return GranularityTime
+}
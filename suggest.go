@@ -0,0 +1,272 @@
+package dateparse
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const maxSuggestions = 10
+
+type Suggestion struct {
+	Text string
+	Date time.Time
+}
+
+var suggestPhrases = vocabularyPhrases()
+
+// russian modifiers agree with the noun in gender and case, the vocabulary keeps every form of a word but neither
+type gender int
+
+const (
+	masculine gender = iota
+	feminine
+	neuter
+)
+
+var (
+	weekdayGenders = map[int]gender{int(time.Sunday): neuter, int(time.Wednesday): feminine, int(time.Friday): feminine,
+		int(time.Saturday): feminine}
+	unitGenders = map[int]gender{int(unitSecond): feminine, int(unitMinute): feminine, int(unitWeek): feminine}
+
+	// the endings of the modifier and of the noun, "" is a noun without an ending:
+	// "в следующий вторник", "в эту пятницу", "в прошлое воскресенье"
+	accusativeEndings = map[gender][2][]string{
+		masculine: {{"ий", "ый", "этот"}, {""}},
+		feminine:  {{"ую", "эту"}, {"у", "ю"}},
+		neuter:    {{"ее", "ое", "это"}, {"е"}},
+	}
+	// masculine only: "в следующем месяце", "в марте"
+	prepositionalEndings = [2][]string{{"ем", "ом"}, {"е"}}
+	// "в году" is not "в годе"
+	locatives = map[int]string{int(unitYear): "году"}
+)
+
+// Suggest completes a partially typed date phrase: "завт" -> "завтра", "next fr" -> "next friday".
+// Only the phrases Parse reads as a whole are suggested, shorter first.
+// The dates are counted from time.Now() when opts has no Now.
+func Suggest(prefix string, opts *Opts) []Suggestion {
+	prefix = strings.Join(strings.Fields(strings.ToLower(prefix)), " ") + suffixSpace(prefix)
+	if strings.TrimSpace(prefix) == "" {
+		return nil
+	}
+	if opts == nil || opts.Now.IsZero() {
+		o := Opts{}
+		if opts != nil {
+			o = *opts
+		}
+		o.Now = time.Now()
+		opts = &o
+	}
+
+	var res []Suggestion
+	seen := make(map[time.Time]bool)
+	for _, text := range suggestCandidates(prefix) {
+		date, msg := Parse(text, opts)
+		if date.IsZero() || msg != "" || seen[date] {
+			continue
+		}
+		seen[date] = true
+		res = append(res, Suggestion{Text: text, Date: date})
+		if len(res) == maxSuggestions {
+			break
+		}
+	}
+	return res
+}
+
+func suffixSpace(s string) string {
+	if strings.HasSuffix(s, " ") && strings.TrimSpace(s) != "" {
+		return " "
+	}
+	return ""
+}
+
+// phrases starting with prefix, numbers are taken from the prefix as typed
+func suggestCandidates(prefix string) []string {
+	var number string
+	for _, field := range strings.Fields(prefix) {
+		// "in a" is an article, not one
+		if field != "a" && field != "an" && checkWordNumber(field) > 0 {
+			number = field
+			break
+		}
+	}
+
+	var phrases []string
+	for _, locale := range []Locale{LocaleRussian, LocaleEnglish} {
+		phrases = append(phrases, localePhrases(localeWords(locale), number)...)
+	}
+	phrases = append(phrases, suggestPhrases...)
+
+	var res []string
+	for _, phrase := range phrases {
+		if strings.HasPrefix(phrase, prefix) {
+			res = append(res, phrase)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return utf8.RuneCountInString(res[i]) < utf8.RuneCountInString(res[j])
+	})
+	return res
+}
+
+func localePhrases(w LocaleWords, number string) []string {
+	res := []string{w.Today, w.Tomorrow, w.AfterTomorrow}
+	res = append(res, w.WeekdayNames[:]...)
+	res = append(res, w.Weekdays[:]...)
+	if number == "" {
+		return res
+	}
+
+	n := checkWordNumber(number)
+	for _, unit := range []durationUnit{unitMinute, unitHour, unitDay, unitWeek, unitMonth, unitYear, unitSecond} {
+		// the number is kept as typed: "через две недели"
+		accusative := strings.TrimPrefix(w.count(n, unit, true), formatNumber(n))
+		nominative := strings.TrimPrefix(w.count(n, unit, false), formatNumber(n))
+		res = append(res, fmt.Sprintf(w.In, number+accusative), fmt.Sprintf(w.Ago, number+nominative))
+	}
+	if day := int(n); float64(day) == n && day <= 31 {
+		for _, month := range w.Months {
+			res = append(res, strings.Replace(fmt.Sprintf(w.Date, day, month), formatNumber(n), number, 1))
+		}
+	}
+	return res
+}
+
+// phrases made of the vocabulary: relative days, day parts, weekdays, months and periods
+func vocabularyPhrases() []string {
+	days := lookupWords(relativeDayLookup, nil)
+	parts := lookupWords(dayPartLookup, func(w string) bool {
+		return latinRegex.MatchString(w) && !strings.Contains(w, ".") || hasEnding(w, "ом", "ем", "ём", "ью")
+	})
+	res := append(append([]string(nil), days...), parts...)
+	for _, day := range days {
+		for _, part := range parts {
+			if latinRegex.MatchString(day) == latinRegex.MatchString(part) {
+				res = append(res, day+" "+part)
+			}
+		}
+	}
+
+	for _, key := range sortedKeys(weekDayNameLookup) {
+		g := weekdayGenders[key]
+		for _, mod := range modifierForms(accusativeEndings[g][0]) {
+			for _, noun := range nounForms(weekDayNameLookup, key, accusativeEndings[g][1]) {
+				res = append(res, "в "+mod+" "+noun)
+			}
+		}
+	}
+	for _, unit := range []durationUnit{unitMonth, unitYear} {
+		nouns := nounForms(unitLookup, int(unit), prepositionalEndings[1])
+		if w, ok := locatives[int(unit)]; ok {
+			nouns = []string{w}
+		}
+		for _, mod := range modifierForms(prepositionalEndings[0]) {
+			for _, noun := range nouns {
+				res = append(res, "в "+mod+" "+noun)
+			}
+		}
+	}
+	for _, unit := range []durationUnit{unitHour, unitDay, unitWeek, unitMonth, unitYear} {
+		g := unitGenders[int(unit)]
+		for _, noun := range nounForms(unitLookup, int(unit), accusativeEndings[g][1]) {
+			res = append(res, "через "+noun)
+		}
+	}
+	for _, key := range sortedKeys(monthLookup) {
+		for _, noun := range nounForms(monthLookup, key, prepositionalEndings[1]) {
+			res = append(res, "в "+noun)
+		}
+	}
+
+	// english words don't change, the locale has them in their plain form
+	en := localeWords(LocaleEnglish)
+	for _, name := range en.WeekdayNames {
+		for _, mod := range []string{"this", "next", "last"} {
+			res = append(res, mod+" "+name)
+		}
+	}
+	for _, unit := range []durationUnit{unitWeek, unitMonth, unitYear} {
+		for _, mod := range []string{"this", "next", "last"} {
+			res = append(res, mod+" "+en.Units.forms(unit)[PluralOne])
+		}
+	}
+	for _, unit := range []durationUnit{unitHour, unitDay, unitWeek, unitMonth, unitYear} {
+		noun := en.Units.forms(unit)[PluralOne]
+		article := "a"
+		if strings.ContainsRune("aeiou", rune(noun[0])) || noun == "hour" {
+			article = "an"
+		}
+		res = append(res, "in "+article+" "+noun)
+	}
+	for _, name := range en.Months {
+		res = append(res, "in "+name)
+	}
+	return res
+}
+
+// the words of a lookup the filter keeps, sorted
+func lookupWords(lookup map[string]int, keep func(string) bool) []string {
+	var res []string
+	for w := range lookup {
+		if keep == nil || keep(w) {
+			res = append(res, w)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+func sortedKeys(lookup map[string]int) []int {
+	seen := make(map[int]bool)
+	var keys []int
+	for _, k := range lookup {
+		if !seen[k] {
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+// the russian modifier forms with the endings, for "этот", "следующий" and "прошлый" alike
+func modifierForms(endings []string) []string {
+	return lookupWords(modifierLookup, func(w string) bool { return !latinRegex.MatchString(w) && hasEnding(w, endings...) })
+}
+
+// the shortest russian form of the key with the endings, "" keeps the forms ending with a consonant:
+// "вторник" and not "вторникам", "среду" and not "средою"
+func nounForms(lookup map[string]int, key int, endings []string) []string {
+	forms := lookupWords(lookup, func(w string) bool {
+		if lookup[w] != key || latinRegex.MatchString(w) {
+			return false
+		}
+		if endings[0] == "" {
+			return !hasEnding(w, "а", "е", "ё", "и", "о", "у", "ы", "э", "ю", "я")
+		}
+		return hasEnding(w, endings...)
+	})
+	if len(forms) == 0 {
+		return nil
+	}
+	shortest := forms[0]
+	for _, w := range forms[1:] {
+		if utf8.RuneCountInString(w) < utf8.RuneCountInString(shortest) {
+			shortest = w
+		}
+	}
+	return []string{shortest}
+}
+
+func hasEnding(w string, endings ...string) bool {
+	for _, e := range endings {
+		if strings.HasSuffix(w, e) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package dateparse
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSuggest(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dt := time.Date(2020, 10, 10, 12, 1, 0, 0, loc)
+	for _, tt := range []struct {
+		prefix string
+		text   string
+		date   time.Time
+	}{
+		{"завт", "завтра", time.Date(2020, 10, 11, 18, 0, 0, 0, loc)},
+		{"Завтра ", "завтра днем", time.Date(2020, 10, 11, 12, 0, 0, 0, loc)},
+		{"next fr", "next friday", time.Date(2020, 10, 23, 18, 0, 0, 0, loc)},
+		{"через 2 ч", "через 2 часа", time.Date(2020, 10, 10, 14, 1, 0, 0, loc)},
+		{"через пять мин", "через пять минут", time.Date(2020, 10, 10, 12, 6, 0, 0, loc)},
+		{"в пят", "в пятницу", time.Date(2020, 10, 16, 18, 0, 0, 0, loc)},
+		{"в сле", "в следующем году", time.Date(2021, 1, 1, 18, 0, 0, 0, loc)},
+		{"4 ма", "4 мая", time.Date(2021, 5, 4, 18, 0, 0, 0, loc)},
+		{"3 days a", "3 days ago", time.Date(2020, 10, 7, 12, 1, 0, 0, loc)},
+		{"in an h", "in an hour", time.Date(2020, 10, 10, 13, 1, 0, 0, loc)},
+		{"в прошлое в", "в прошлое воскресенье", time.Date(2020, 10, 4, 18, 0, 0, 0, loc)},
+		{"в ма", "в мае", time.Date(2021, 5, 1, 18, 0, 0, 0, loc)},
+	} {
+		t.Run(tt.prefix, func(t *testing.T) {
+			res := Suggest(tt.prefix, &Opts{Now: dt})
+			if len(res) == 0 {
+				t.Fatal("no suggestions")
+			}
+			if res[0].Text != tt.text || !res[0].Date.Equal(tt.date) {
+				t.Errorf("got '%s' %s want '%s' %s", res[0].Text, res[0].Date, tt.text, tt.date)
+			}
+		})
+	}
+
+	res := Suggest("в сле", &Opts{Now: dt})
+	texts := make(map[string]bool)
+	for _, s := range res {
+		texts[s.Text] = true
+	}
+	for _, want := range []string{"в следующем месяце", "в следующую пятницу", "в следующий понедельник"} {
+		if !texts[want] {
+			t.Errorf("'%s' not suggested in %v", want, res)
+		}
+	}
+
+	for _, text := range suggestCandidates("в сле") {
+		if text == "в следующему среду" || text == "в следующий вторникам" {
+			t.Errorf("'%s' is not a phrase", text)
+		}
+	}
+
+	// the dates are counted from now without opts
+	if res := Suggest("через 2 ч", nil); len(res) == 0 || res[0].Date.Before(time.Now()) {
+		t.Errorf("got %v want a date after now", res)
+	}
+
+	for _, prefix := range []string{"", "  ", "qwerty"} {
+		if res := Suggest(prefix, &Opts{Now: dt}); len(res) != 0 {
+			t.Errorf("'%s': unexpected suggestions %v", prefix, res)
+		}
+	}
+}
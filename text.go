@@ -0,0 +1,100 @@
+package dateparse
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// text is the lowercased input rewritten by the parser, it remembers where every byte came from
+type text struct {
+	s   string
+	pos []int // offset in the original of the byte s[i], pos[len(s)] is the end of the original
+}
+
+// span is a piece of the input a date was read from
+type span struct {
+	text       string // as the parser saw it: lowercased, typos corrected
+	start, end int    // byte offsets in the original input
+}
+
+func newText(s string) text {
+	var b strings.Builder
+	pos := make([]int, 0, len(s)+1)
+	for i, r := range s {
+		n := b.Len()
+		b.WriteRune(unicode.ToLower(r))
+		for ; n < b.Len(); n++ {
+			pos = append(pos, i)
+		}
+	}
+	return text{s: b.String(), pos: append(pos, len(s))}
+}
+
+func (t text) replace(start int, end int, repl string) text {
+	pos := make([]int, 0, len(t.pos)-(end-start)+len(repl))
+	pos = append(pos, t.pos[:start]...)
+	for range []byte(repl) {
+		pos = append(pos, t.pos[start])
+	}
+	pos = append(pos, t.pos[end:]...)
+	return text{s: t.s[:start] + repl + t.s[end:], pos: pos}
+}
+
+func (t text) replaceAll(old string, repl string) text {
+	for i := strings.Index(t.s, old); i >= 0; {
+		t = t.replace(i, i+len(old), repl)
+		next := strings.Index(t.s[i+len(repl):], old)
+		if next < 0 {
+			break
+		}
+		i += len(repl) + next
+	}
+	return t
+}
+
+func (t text) trimSpace() text {
+	end := len(strings.TrimRightFunc(t.s, unicode.IsSpace))
+	start := end - len(strings.TrimLeftFunc(t.s[:end], unicode.IsSpace))
+	return text{s: t.s[start:end], pos: t.pos[start : end+1]}
+}
+
+func (t text) span(start int, end int) span {
+	return span{text: t.s[start:end], start: t.pos[start], end: t.pos[end]}
+}
+
+// finds match like cutMatch does and removes it with one of the spaces around
+func (t text) cut(match string) (text, span, bool) {
+	match = matchSpan(match)
+	i := strings.Index(t.s, match)
+	if i < 0 || match == "" {
+		return t, span{}, false
+	}
+	sp := t.span(i, i+len(match))
+	end := i + len(match)
+	if strings.HasSuffix(t.s[:i], " ") && strings.HasPrefix(t.s[end:], " ") {
+		end++
+	}
+	return t.replace(i, end, "").trimSpace(), sp, true
+}
+
+// the input without the spans, lowercased: what is left for the message
+func cutSpans(s string, spans []span) string {
+	spans = append([]span(nil), spans...)
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	var b strings.Builder
+	pos := 0
+	for _, sp := range spans {
+		if sp.start < pos {
+			continue
+		}
+		b.WriteString(s[pos:sp.start])
+		pos = sp.end
+		// a removed span leaves one space, not two
+		if strings.HasSuffix(b.String(), " ") && strings.HasPrefix(s[pos:], " ") {
+			pos++
+		}
+	}
+	b.WriteString(s[pos:])
+	return strings.TrimSpace(strings.ToLower(b.String()))
+}
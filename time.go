@@ -8,73 +8,157 @@ import (
 )
 
 var (
-	timePrefix = `(с|в|к|by|at)`
-	timeSuffix = `(утра|вечера|мин[у]?[т]?|a[.]?m|p[.]?m)`
+	timePrefix  = `(с|в|к|by|at)`
+	timeSuffix  = `(утра|вечера|дня|ночи|мин[у]?[т]?|a[.]?m|p[.]?m)`
+	meridiem    = `a\.?\s?m\b\.?|p\.?\s?m\b\.?`
+	clockSuffix = `(` + meridiem + `|утра|дня|вечера|ночи)`
+	approxTime  = `(?:-?ish)?`
 )
 
+var hourWords = alternation(strings.Join(ruUnits[1:], "|"), strings.Join(enUnits[1:], "|"), strings.Join(ruTeens[:3], "|"),
+	strings.Join(enTeens[:3], "|"))
+
 var (
-	hhmmRegex                = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?%s[.:]%s`, timePrefix, hourHH, minuteMM))
-	hhRegex                  = regexp.MustCompile(fmt.Sprintf(`%s[" "]%s\s?%s?`, timePrefix, hourHH, timeSuffix))
-	baseTimeOrientationRegex = regexp.MustCompile(fmt.Sprintf(`%s?%s?[" "]?%s`, timePrefix, datePrefix, durationSuffix))
+	clock12Regex             = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?\b%s(?:[.:]%s)?\s?%s%s`, timePrefix, hourHH, minuteMM, clockSuffix, approxTime))
+	hhmmRegex                = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?%s[.:hч]%s(?::%s)?`, timePrefix, hourHH24, minuteMM, secondSS))
+	hhmmDashRegex            = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(в|at)[" "]%s-%s(?:[" "]|$|[.,])`, hourHH24, minuteMM))
+	militaryRegex            = regexp.MustCompile(`(?:^|[" "])(?:в|к|at)[" "](0[0-9]|1[0-9]|2[0-3])([0-5][0-9])(?:[" "]?(?:hrs|ч)|[" "]?(году|года|год|г))?(?:[" "]|$|[.,])`)
+	hourOnlyRegex            = regexp.MustCompile(fmt.Sprintf(`%s?[" "]?\b%s[" "]?(?:ч|h)(?:[" "]|$)`, timePrefix, hourHH24))
+	hhRegex                  = regexp.MustCompile(fmt.Sprintf(`%s[" "]%s\b\s?%s?`, timePrefix, hourHH, timeSuffix))
+	hhWordsRegex             = regexp.MustCompile(fmt.Sprintf(`%s[" "](%s)(?:[" "]%s|[" "](?:%s)|$)`, timePrefix, hourWords, timeSuffix, hours))
+	baseTimeOrientationRegex = regexp.MustCompile(fmt.Sprintf(`%s?%s?[" "]?%s%s`, timePrefix, datePrefix, durationSuffix, approxTime))
+
+	dayClockRegex = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(?:в|к|с)[" "]%s(?:[.:]%s)?[" "]дня`, hourHH, minuteMM))
+)
+
+type meridiemKind int
+
+const (
+	meridiemUnknown meridiemKind = iota
+	meridiemAM
+	meridiemPM
+	meridiemAfternoon
+	meridiemNight
 )
 
+var meridiemLookup = makeLookup(map[int]string{
+	int(meridiemAM):        `am|утра`,
+	int(meridiemPM):        `pm|вечера`,
+	int(meridiemAfternoon): `дня`,
+	int(meridiemNight):     `ночи`,
+})
+
+func parseMeridiem(s string) meridiemKind {
+	s = strings.NewReplacer(".", "", " ", "").Replace(s)
+	return meridiemKind(meridiemLookup[s])
+}
+
+func applyMeridiem(hour int, suffix string) int {
+	switch parseMeridiem(suffix) {
+	case meridiemAM:
+		if hour == 12 {
+			return 0
+		}
+		if hour > 12 {
+			return hour - 12
+		}
+	case meridiemPM:
+		if hour < 12 {
+			return hour + 12
+		}
+	case meridiemAfternoon:
+		if hour < 6 {
+			return hour + 12
+		}
+	case meridiemNight:
+		if hour == 12 {
+			return 0
+		}
+		if hour >= 9 && hour < 12 {
+			return hour + 12
+		}
+	}
+	return hour
+}
+
+// clock phrases a date pattern would misread, they are parsed before the date:
+// "в 3 дня" is a time of day, not a three days duration, "20 minutes to 5" is not in 20 minutes
+func clockFirst(s string) bool {
+	return dayClockRegex.MatchString(s) || ruMinutesPastRegex.MatchString(s) || enPastRegex.MatchString(s) || enToRegex.MatchString(s) ||
+		hasMilitary(s)
+}
+
 func parseTime(s string, opts Opts) (t time.Time, st string) {
+	if t, st, ok := parseClockPhrase(s, opts); ok {
+		return t, st
+	}
 	switch {
+	case dayBoundary(s) != "":
+		return parseDayBoundary(s, opts)
+	case clock12Regex.MatchString(s):
+		return calculateClock(clock12Regex.FindStringSubmatch(s), opts, 2, 3, 0, 4)
 	case hhmmRegex.MatchString(s):
-		return calculateTime(hhmmRegex.FindStringSubmatch(s), opts)
+		return calculateClock(hhmmRegex.FindStringSubmatch(s), opts, 2, 3, 4, 0)
+	case hhmmDashRegex.MatchString(s):
+		return calculateClock(hhmmDashRegex.FindStringSubmatch(s), opts, 2, 3, 0, 0)
+	case hasMilitary(s):
+		return calculateMilitary(militaryRegex.FindStringSubmatch(s), opts)
+	case hourOnlyRegex.MatchString(s):
+		return calculateClock(hourOnlyRegex.FindStringSubmatch(s), opts, 2, 0, 0, 0)
 	case hhRegex.MatchString(s):
-		return calculateTime(hhRegex.FindStringSubmatch(s), opts)
+		return calculateClock(hhRegex.FindStringSubmatch(s), opts, 2, 0, 0, 3)
+	case hhWordsRegex.MatchString(s):
+		return calculateClock(hhWordsRegex.FindStringSubmatch(s), opts, 2, 0, 0, 3)
 	case baseTimeOrientationRegex.MatchString(s):
 		return calculateTime(baseTimeOrientationRegex.FindStringSubmatch(s), opts)
 	}
 	return opts.Now, st
 }
 
+// 24:00 lands on the next day
+func calculateClock(m []string, opts Opts, hourPosition int, minutePosition int, secondPosition int, suffixPosition int) (time.Time, string) {
+	hour := forceNumber(m[hourPosition])
+	minute, second := 0, 0
+	if minutePosition > 0 {
+		minute = forceInt(m[minutePosition])
+	}
+	if secondPosition > 0 {
+		second = forceInt(m[secondPosition])
+	}
+	if suffixPosition > 0 {
+		hour = applyMeridiem(hour, m[suffixPosition])
+	}
+	if hour == 24 && (minute != 0 || second != 0) {
+		return opts.Now, ""
+	}
+	return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), hour, minute, second, opts), m[0]
+}
+
+// "в 0930", "at 1430 hrs": without the leading zero the hours suffix is needed, "в 2023" is a year
+// "в 1930", "at 0900 hrs", but "в 1999 году" is a year
+func hasMilitary(s string) bool {
+	m := militaryRegex.FindStringSubmatch(s)
+	return m != nil && m[3] == ""
+}
+
+func calculateMilitary(m []string, opts Opts) (time.Time, string) {
+	return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), forceInt(m[1]), forceInt(m[2]), 0, opts), m[0]
+}
+
 func calculateTime(t []string, opts Opts) (time.Time, string) {
 	m := normalizeStrings(t[1:])
-	switch len(m) {
-	case 4:
-		hour := forceInt(m[2])
-		minute := forceInt(m[3])
-		return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), hour, minute, 0, opts), t[0]
-	case 3:
-		hour := forceInt(m[1])
-		switch {
-		case strings.Contains(morning, m[2]):
-			if hour > 12 {
-				hour -= 12
-			}
-			return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), hour, 0, 0, opts), t[0]
-		case strings.Contains(evening, m[2]):
-			if hour < 12 {
-				hour += 12
-			}
-			return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), hour, 0, 0, opts), t[0]
-		}
-		minute := forceInt(m[2])
-		return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), hour, minute, 0, opts), t[0]
-	case 2:
-		switch {
-		case strings.Contains(morning, m[1]):
-			return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), 10, 0, 0, opts), t[0]
-		case strings.Contains(noon, m[1]):
-			return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), 12, 0, 0, opts), t[0]
-		case strings.Contains(timePrefix, m[0]):
-			return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), forceInt(m[1]), 0, 0, opts), t[0]
-		}
-		return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), forceInt(m[0]), forceInt(m[1]), 0, opts), t[0]
-	case 1:
-		switch {
-		case strings.Contains(morning, m[0]):
-			return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), 10, 0, 0, opts), t[0]
-		case strings.Contains(evening, m[0]):
-			return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), 18, 0, 0, opts), t[0]
-		case strings.Contains(noon, m[0]):
-			return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), 12, 0, 0, opts), t[0]
-		case strings.Contains(midnight, m[0]):
-			return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), 0, 0, 0, opts), t[0]
-
-		}
+	hour := 0
+	switch parseDayPart(m[len(m)-1]) {
+	case partMorning:
+		hour = 10
+	case partNoon:
+		hour = 12
+	case partEvening:
+		hour = 18
+	case partMidnight:
+		hour = 0
+	default:
+		return opts.Now, t[0]
 	}
-	return opts.Now, t[0]
+	return getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), hour, 0, 0, opts), t[0]
 }
@@ -0,0 +1,95 @@
+package dateparse
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// timestamps pasted by bots and integrations, they are exact and need no guessing
+var (
+	isoTimestampRegex  = regexp.MustCompile(`(?:^|[" "])(\d{4})-(\d\d)-(\d\d)([t" "])(\d\d):(\d\d)(?::(\d\d)(?:[.,](\d{1,9}))?)?(z|[+-]\d\d(?::?\d\d)?)?(?:[" "]|$|[.,])`)
+	rfc2822Regex       = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(?:(?:%s),[" "]?)?(\d\d?)[" "](%s)[" "](\d{4})[" "](\d\d):(\d\d)(?::(\d\d))?[" "]([+-]\d{4}|ut|z|%s)(?:[" "]|$|[.,])`, latinWords(shortWeeks), enMonths, zoneAbbreviationWords))
+	unixTimestampRegex = regexp.MustCompile(`(?:^|[" "])(@?)(1\d{9})(?:[.](\d{1,9}))?(\d{3})?(?:[" "]|$|[.,])`)
+)
+
+// returns the timestamp, its span in s and the location it mentions
+func parseTimestamp(s string, opts Opts) (time.Time, string, *time.Location, bool) {
+	switch {
+	case isoTimestampRegex.MatchString(s):
+		m := isoTimestampRegex.FindStringSubmatch(s)
+		// "2024-03-05 10:00" without an offset is an ordinary user date
+		if m[4] == " " && m[9] == "" {
+			return time.Time{}, "", nil, false
+		}
+		loc := timestampLocation(m[9], opts)
+		date := time.Date(forceInt(m[1]), time.Month(forceInt(m[2])), forceInt(m[3]),
+			forceInt(m[5]), forceInt(m[6]), forceInt(m[7]), fractionNanos(m[8]), loc)
+		if !sameFields(date, m[1], m[2], m[3], m[5], m[6], m[7]) {
+			return time.Time{}, "", nil, false
+		}
+		return date, matchSpan(m[0]), textLocation(m[9], loc), true
+	case rfc2822Regex.MatchString(s):
+		m := rfc2822Regex.FindStringSubmatch(s)
+		month, _ := parseMonth(m[2])
+		loc := timestampLocation(m[7], opts)
+		date := time.Date(forceInt(m[3]), month, forceInt(m[1]), forceInt(m[4]), forceInt(m[5]), forceInt(m[6]), 0, loc)
+		if !sameFields(date, m[3], fmt.Sprint(int(month)), m[1], m[4], m[5], m[6]) {
+			return time.Time{}, "", nil, false
+		}
+		return date, matchSpan(m[0]), loc, true
+	case unixTimestampRegex.MatchString(s):
+		m := unixTimestampRegex.FindStringSubmatch(s)
+		// "order 1234567890 shipped" is an ordinary number, a timestamp is marked with "@" or comes alone
+		if m[1] == "" && matchSpan(m[0]) != s {
+			return time.Time{}, "", nil, false
+		}
+		nanos := fractionNanos(m[3])
+		if m[4] != "" {
+			nanos = forceInt(m[4]) * int(time.Millisecond)
+		}
+		return time.Unix(forceInt64(m[2]), int64(nanos)), matchSpan(m[0]), nil, true
+	}
+	return time.Time{}, "", nil, false
+}
+
+// time.Date moves "2024-13-32" to the next year, a timestamp with such fields is not a timestamp
+func sameFields(t time.Time, year, month, day, hour, minute, second string) bool {
+	return t.Year() == forceInt(year) && int(t.Month()) == forceInt(month) && t.Day() == forceInt(day) &&
+		t.Hour() == forceInt(hour) && t.Minute() == forceInt(minute) && t.Second() == forceInt(second)
+}
+
+func timestampLocation(zone string, opts Opts) *time.Location {
+	switch zone {
+	case "":
+		return opts.Now.Location()
+	case "z", "ut":
+		return time.UTC
+	}
+	if offset, ok := zoneAbbreviations[zone]; ok {
+		return time.FixedZone(strings.ToUpper(zone), int(offset.Seconds()))
+	}
+	digits := strings.Replace(zone[1:], ":", "", 1)
+	offset := forceInt(digits[:2])*3600 + forceInt(digits[2:])*60
+	if zone[0] == '-' {
+		offset = -offset
+	}
+	return time.FixedZone("UTC"+zone, offset)
+}
+
+// the location is reported only when the text mentions it
+func textLocation(zone string, loc *time.Location) *time.Location {
+	if zone == "" {
+		return nil
+	}
+	return loc
+}
+
+// ".5" is half a second, ".123456789" is nanoseconds
+func fractionNanos(s string) int {
+	if s == "" {
+		return 0
+	}
+	return forceInt((s + "000000000")[:9])
+}
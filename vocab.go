@@ -0,0 +1,53 @@
+package dateparse
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var latinRegex = regexp.MustCompile(`^[a-z. ]+$`)
+
+// maps every word of a pattern to its key, a word under two keys is a mistake in the vocabulary
+func makeLookup(patterns map[int]string) map[string]int {
+	res := make(map[string]int)
+	for key, p := range patterns {
+		for _, w := range splitAlternation(p) {
+			if k, ok := res[w]; ok && k != key {
+				panic(fmt.Sprintf("dateparse: %q is both %d and %d", w, k, key))
+			}
+			res[w] = key
+		}
+	}
+	return res
+}
+
+func makeSet(patterns ...string) map[string]bool {
+	res := make(map[string]bool)
+	for _, p := range patterns {
+		for _, w := range splitAlternation(p) {
+			res[w] = true
+		}
+	}
+	return res
+}
+
+func splitAlternation(p string) []string {
+	var res []string
+	for _, w := range strings.Split(strings.Trim(p, "()"), "|") {
+		if w != "" {
+			res = append(res, w)
+		}
+	}
+	return res
+}
+
+func latinWords(pattern string) string {
+	var res []string
+	for _, w := range splitAlternation(pattern) {
+		if latinRegex.MatchString(w) {
+			res = append(res, w)
+		}
+	}
+	return strings.Join(res, "|")
+}
@@ -6,14 +6,14 @@ import (
 )
 
 var (
-	sunday    = `воскресенье|sunday`
-	monday    = `понедельник|monday`
-	tuesday   = `вторник|tuesday`
-	wednesday = `среду|среда|wednesday`
-	thursday  = `четверг|thursday`
-	friday    = `пятницу|пятница|friday`
-	saturday  = `субботу|суббота|saturday`
-	weeks     = strings.Join([]string{
+	sunday    = alternation(ruForms("воскресен", neutSoft), `sunday|sundays`)
+	monday    = alternation(ruForms("понедельник", mascVelar), `monday|mondays`)
+	tuesday   = alternation(ruForms("вторник", mascVelar), `tuesday|tuesdays`)
+	wednesday = alternation(ruForms("сред", femHard[:6]), `wednesday|wednesdays`)
+	thursday  = alternation(ruForms("четверг", mascVelar), `thursday|thursdays`)
+	friday    = alternation(ruForms("пятниц", femTs[:6]), `friday|fridays`)
+	saturday  = alternation(ruForms("суббот", femHard[:6]), `saturday|saturdays`)
+	weeks     = alternation(
 		sunday,
 		monday,
 		tuesday,
@@ -21,13 +21,13 @@ var (
 		thursday,
 		friday,
 		saturday,
-	}, "|")
+	)
 )
 
 var (
 	shortSunday    = `вс|воскр|sun`
 	shortMonday    = `пн|пнд|понед|mon`
-	shortTuesday   = `вт|thu`
+	shortTuesday   = `вт|tue|tues`
 	shortWednesday = `ср|wed`
 	shortThursday  = `чт|thu`
 	shortFriday    = `пт|fri`
@@ -43,37 +43,43 @@ var (
 	}, "|")
 )
 
+// the full names, without the abbreviations
+var weekDayNameLookup = makeLookup(map[int]string{
+	int(time.Sunday):    sunday,
+	int(time.Monday):    monday,
+	int(time.Tuesday):   tuesday,
+	int(time.Wednesday): wednesday,
+	int(time.Thursday):  thursday,
+	int(time.Friday):    friday,
+	int(time.Saturday):  saturday,
+})
+
+var weekDayLookup = makeLookup(map[int]string{
+	int(time.Sunday):    sunday + "|" + shortSunday,
+	int(time.Monday):    monday + "|" + shortMonday,
+	int(time.Tuesday):   tuesday + "|" + shortTuesday,
+	int(time.Wednesday): wednesday + "|" + shortWednesday,
+	int(time.Thursday):  thursday + "|" + shortThursday,
+	int(time.Friday):    friday + "|" + shortFriday,
+	int(time.Saturday):  saturday + "|" + shortSaturday,
+})
+
 func parseWeekDay(s string, opts Opts) time.Time {
 	date := getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), opts.TodayEndHour, 0, 0, opts)
-	if weakDay := parseWeekDays(s); weakDay < 7 {
-		v := weakDay - int(date.Weekday())
+	if weekDay, ok := parseWeekDays(s); ok {
+		v := int(weekDay) - int(date.Weekday())
 		if v < 0 {
-			date = date.Add(time.Duration(v)*24*time.Hour + 7*24*time.Hour)
+			date = date.AddDate(0, 0, v+7)
 		} else {
-			date = date.Add(time.Duration(v) * 24 * time.Hour)
+			date = date.AddDate(0, 0, v)
 		}
 	}
 	return date
 }
 
-func parseWeekDays(s string) int {
-	switch {
-	case strings.Contains(strings.Join([]string{sunday, shortSunday}, "|"), s):
-		return 0
-	case strings.Contains(strings.Join([]string{monday, shortMonday}, "|"), s):
-		return 1
-	case strings.Contains(strings.Join([]string{tuesday, shortTuesday}, "|"), s):
-		return 2
-	case strings.Contains(strings.Join([]string{wednesday, shortWednesday}, "|"), s):
-		return 3
-	case strings.Contains(strings.Join([]string{thursday, shortThursday}, "|"), s):
-		return 4
-	case strings.Contains(strings.Join([]string{friday, shortFriday}, "|"), s):
-		return 5
-	case strings.Contains(strings.Join([]string{saturday, shortSaturday}, "|"), s):
-		return 6
-	}
-	return 7
+func parseWeekDays(s string) (time.Weekday, bool) {
+	d, ok := weekDayLookup[s]
+	return time.Weekday(d), ok
 }
 
 func calculateWeekDuration(m []string, opts Opts, weekPosition int) (time.Time, string) {
@@ -83,27 +89,31 @@ func calculateWeekDuration(m []string, opts Opts, weekPosition int) (time.Time,
 		timePosition = weekPosition - 2
 	}
 	date := parseWeekDay(m[weekPosition], opts)
-	switch {
-	case strings.Contains(durPrefix, m[weekPosition-1]):
-		date = date.Add(24 * 7 * time.Hour)
+	past := false
+	switch prefix := m[weekPosition-1]; {
+	case durPrefixSet[prefix]:
+		date = date.AddDate(0, 0, 7)
+	case lastWordsSet[prefix]:
+		date = date.AddDate(0, 0, -7)
+		past = true
 	}
 	if len(m) > 3 {
-		switch {
-		case strings.Contains(morning, m[timePosition]) && m[timePosition] != "":
+		switch parseDayPart(m[timePosition]) {
+		case partMorning:
 			if date.Weekday() == opts.Now.Weekday() && opts.Now.Hour() > 10 {
-				date = date.Add(24 * 7 * time.Hour)
+				date = date.AddDate(0, 0, 7)
 			}
 			return getDate(date.Year(), date.Month(), date.Day(), 10, 0, 0, opts), m[0]
-		case strings.Contains(evening, m[timePosition]) && m[timePosition] != "":
+		case partEvening:
 			return date, m[0]
-		case strings.Contains(noon, m[timePosition]) && m[timePosition] != "":
+		case partNoon:
 			return getDate(date.Year(), date.Month(), date.Day(), 12, 0, 0, opts), m[0]
-		case strings.Contains(midnight, m[timePosition]) && m[timePosition] != "":
+		case partMidnight:
 			return getDate(date.Year(), date.Month(), date.Day(), 0, 0, 0, opts), m[0]
 		}
 	}
-	if date.Before(opts.Now) {
-		date = date.Add(7 * 24 * time.Hour)
+	if date.Before(opts.Now) && !past {
+		date = date.AddDate(0, 0, 7)
 	}
 	return date, m[0]
 }
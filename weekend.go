@@ -0,0 +1,73 @@
+package dateparse
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+var (
+	weekend      = alternation(`выходные|выходных|выходным|выходными|уикенд|уикенда|уикенде|уик-энд|уик-энда|weekend|weekends`)
+	weekdayGroup = alternation(ruForms("будн", []string{"и", "ей", "ям", "ями", "ях"}), `будний день|будние дни|weekdays|weekday|workweek`)
+
+	weekendPrefix = `(?:(?:на|в|во|по|on|at|over|during|for)[" "])?(?:the[" "])?`
+)
+
+const (
+	groupWeekend = iota
+	groupWeekdays
+)
+
+var (
+	weekendLookup = makeLookup(map[int]string{
+		groupWeekend:  weekend,
+		groupWeekdays: weekdayGroup,
+	})
+
+	weekendDays  = []time.Weekday{time.Saturday, time.Sunday}
+	weekdayDays  = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	weekendGroup = map[int][]time.Weekday{
+		groupWeekend:  weekendDays,
+		groupWeekdays: weekdayDays,
+	}
+)
+
+var weekendRegex = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])%s(?:(%s)[" "])?(%s|%s)(?:[" "]|$|[.,])`, weekendPrefix, periodModifier, weekend, weekdayGroup))
+
+// "на выходных", "next weekend", "по будням": the first day of the group that is still ahead
+func calculateWeekend(m []string, opts Opts) (time.Time, string) {
+	group := weekendGroup[weekendLookup[m[2]]]
+	day := getDate(opts.Now.Year(), opts.Now.Month(), opts.Now.Day(), opts.TodayEndHour, 0, 0, opts)
+	for !hasWeekday(group, day.Weekday()) || day.Before(opts.Now) {
+		day = day.AddDate(0, 0, 1)
+	}
+	if shift := periodShift(m[1]); shift != 0 {
+		// the other weekend starts on its saturday even if this one is half over
+		day = day.AddDate(0, 0, 7*shift-(int(day.Weekday()-group[0])+7)%7)
+	}
+	return day, m[0]
+}
+
+// the days of the week a weekend or weekdays phrase covers, a copy the caller may change
+func weekdaysOf(spans []span) []time.Weekday {
+	for _, sp := range spans {
+		if m := weekendRegex.FindStringSubmatch(sp.text); m != nil {
+			return append([]time.Weekday(nil), weekendGroup[weekendLookup[m[2]]]...)
+		}
+	}
+	return nil
+}
+
+// the last day of the group the date belongs to, at the same time
+func lastWeekday(date time.Time, group []time.Weekday) time.Time {
+	return date.AddDate(0, 0, (int(group[len(group)-1]-date.Weekday())+7)%7)
+}
+
+func hasWeekday(group []time.Weekday, day time.Weekday) bool {
+	for _, d := range group {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
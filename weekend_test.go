@@ -0,0 +1,53 @@
+package dateparse
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWeekend(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	saturday := time.Date(2020, 10, 10, 12, 1, 0, 0, loc)
+	wednesday := time.Date(2020, 10, 7, 12, 1, 0, 0, loc)
+	sundayEvening := time.Date(2020, 10, 11, 19, 1, 0, 0, loc)
+	for _, tt := range []struct {
+		input    string
+		now      time.Time
+		date     time.Time
+		end      time.Time
+		msg      string
+		weekdays []time.Weekday
+	}{
+		{"на выходных", wednesday, time.Date(2020, 10, 10, 18, 0, 0, 0, loc), time.Date(2020, 10, 11, 18, 0, 0, 0, loc), "", weekendDays},
+		{"в эти выходные", saturday, time.Date(2020, 10, 10, 18, 0, 0, 0, loc), time.Date(2020, 10, 11, 18, 0, 0, 0, loc), "", weekendDays},
+		{"на выходных", sundayEvening, time.Date(2020, 10, 17, 18, 0, 0, 0, loc), time.Date(2020, 10, 18, 18, 0, 0, 0, loc), "", weekendDays},
+		{"next weekend", wednesday, time.Date(2020, 10, 17, 18, 0, 0, 0, loc), time.Date(2020, 10, 18, 18, 0, 0, 0, loc), "", weekendDays},
+		{"на следующих выходных съездить на дачу", saturday, time.Date(2020, 10, 17, 18, 0, 0, 0, loc), time.Date(2020, 10, 18, 18, 0, 0, 0, loc), "съездить на дачу", weekendDays},
+		{"на прошлых выходных", wednesday, time.Date(2020, 10, 3, 18, 0, 0, 0, loc), time.Date(2020, 10, 4, 18, 0, 0, 0, loc), "", weekendDays},
+		{"this weekend at 10am", wednesday, time.Date(2020, 10, 10, 10, 0, 0, 0, loc), time.Date(2020, 10, 11, 10, 0, 0, 0, loc), "", weekendDays},
+		{"по выходным бегать", wednesday, time.Date(2020, 10, 10, 18, 0, 0, 0, loc), time.Date(2020, 10, 11, 18, 0, 0, 0, loc), "бегать", weekendDays},
+		{"по будням в 9:00 зарядка", saturday, time.Date(2020, 10, 12, 9, 0, 0, 0, loc), time.Date(2020, 10, 16, 9, 0, 0, 0, loc), "зарядка", weekdayDays},
+		{"weekdays", wednesday, time.Date(2020, 10, 7, 18, 0, 0, 0, loc), time.Date(2020, 10, 9, 18, 0, 0, 0, loc), "", weekdayDays},
+		{"завтра", wednesday, time.Date(2020, 10, 8, 18, 0, 0, 0, loc), time.Time{}, "", nil},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			res := ParseResult(tt.input, &Opts{Now: tt.now})
+			if !res.Date.Equal(tt.date) || !res.End.Equal(tt.end) || res.Message != tt.msg {
+				t.Errorf("got %s - %s '%s' want %s - %s '%s'", res.Date, res.End, res.Message, tt.date, tt.end, tt.msg)
+			}
+			if !reflect.DeepEqual(res.Weekdays, tt.weekdays) {
+				t.Errorf("got weekdays %v want %v", res.Weekdays, tt.weekdays)
+			}
+		})
+	}
+
+	res := ParseResult("на выходных", &Opts{Now: wednesday})
+	res.Weekdays[0] = time.Monday
+	if weekendDays[0] != time.Saturday {
+		t.Error("Result.Weekdays must not share the package days")
+	}
+}
@@ -0,0 +1,125 @@
+package dateparse
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Calendar tells working days from days off. Only the date of day matters, not its clock.
+type Calendar interface {
+	IsWorkday(day time.Time) bool
+}
+
+// CalendarFunc lets an ordinary function be a Calendar.
+type CalendarFunc func(day time.Time) bool
+
+func (f CalendarFunc) IsWorkday(day time.Time) bool { return f(day) }
+
+// WeekendCalendar has saturday and sunday off and no holidays, it is used by default.
+var WeekendCalendar Calendar = CalendarFunc(func(day time.Time) bool {
+	return day.Weekday() != time.Saturday && day.Weekday() != time.Sunday
+})
+
+// DayShift is where a date landing on a day off is moved.
+type DayShift int
+
+const (
+	ShiftNone     DayShift = iota // the date stays
+	ShiftForward                  // to the next working day
+	ShiftBackward                 // to the previous working day
+)
+
+var (
+	workday = fmt.Sprintf(`(?:%s)[" "](?:%s)|%s`, ruForms("рабоч", adjSoft), days,
+		alternation(`business days|business day|working days|working day|workdays|workday`))
+	workdayCount = fmt.Sprintf(`(?:(\d+(?:[.,]\d+)?|%s)[" "])?`, wordNumbers)
+)
+
+var (
+	workdayDurRegex  = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(через|in)[" "]%s(%s)(?:[" "]|$|[.,])`, workdayCount, workday))
+	workdayAgoRegex  = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])%s(%s)[" "](?:назад|ago)(?:[" "]|$|[.,])`, workdayCount, workday))
+	nextWorkdayRegex = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(?:(?:в|на|on)[" "])?(?:the[" "])?(%s|%s)[" "](%s)(?:[" "]|$|[.,])`, nextWords, lastWords, workday))
+)
+
+func workCalendar(opts Opts) Calendar {
+	if opts.Calendar != nil {
+		return opts.Calendar
+	}
+	return WeekendCalendar
+}
+
+// "через 3 рабочих дня", "in 5 business days", "2 working days ago"
+func calculateWorkdayDuration(m []string, opts Opts, numberPosition int, sign int) (time.Time, string) {
+	n := 1
+	if number := m[numberPosition]; number != "" {
+		n = int(workdayNumber(number))
+	}
+	return addWorkdays(opts.Now, sign*n, opts), m[0]
+}
+
+func workdayNumber(number string) float64 {
+	return checkWordNumber(strings.Replace(number, ",", ".", 1))
+}
+
+// hides "полтора рабочих дня": it has no exact day, but the rest of the text still has a date
+func maskFractionalWorkdays(in text, marker string) text {
+	for _, re := range []*regexp.Regexp{workdayDurRegex, workdayAgoRegex} {
+		matches := re.FindAllStringSubmatchIndex(in.s, -1)
+		for i := len(matches) - 1; i >= 0; i-- {
+			idx := matches[i]
+			// the count is the last but one group
+			start, end := idx[len(idx)-4], idx[len(idx)-3]
+			if start < 0 {
+				continue
+			}
+			if v := workdayNumber(in.s[start:end]); v != float64(int(v)) {
+				in = in.replace(start, idx[len(idx)-1], marker)
+			}
+		}
+	}
+	return in
+}
+
+// "следующий рабочий день", "previous business day"
+func calculateNextWorkday(m []string, opts Opts) (time.Time, string) {
+	sign := 1
+	if lastWordsSet[m[1]] {
+		sign = -1
+	}
+	day := addWorkdays(opts.Now, sign, opts)
+	return getDate(day.Year(), day.Month(), day.Day(), opts.TodayEndHour, 0, 0, opts), m[0]
+}
+
+// counts n working days from t, the clock stays
+func addWorkdays(t time.Time, n int, opts Opts) time.Time {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for ; n > 0; n-- {
+		t = nextWorkday(t.AddDate(0, 0, step), step, opts)
+	}
+	return t
+}
+
+// skips days off in the given direction
+func nextWorkday(day time.Time, step int, opts Opts) time.Time {
+	calendar := workCalendar(opts)
+	// a calendar without working days must not hang the parser
+	for i := 0; i < 366 && !calendar.IsWorkday(day); i++ {
+		day = day.AddDate(0, 0, step)
+	}
+	return day
+}
+
+func shiftToWorkday(t time.Time, opts Opts) time.Time {
+	switch opts.NonWorkingShift {
+	case ShiftForward:
+		return nextWorkday(t, 1, opts)
+	case ShiftBackward:
+		return nextWorkday(t, -1, opts)
+	}
+	return t
+}
@@ -0,0 +1,55 @@
+package dateparse
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorkdays(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dt := time.Date(2020, 10, 9, 12, 1, 0, 0, loc) // friday
+	// monday october 12 is a holiday
+	holidays := CalendarFunc(func(day time.Time) bool {
+		return WeekendCalendar.IsWorkday(day) && !(day.Month() == time.October && day.Day() == 12)
+	})
+	for _, tt := range []struct {
+		input    string
+		calendar Calendar
+		shift    DayShift
+		want     time.Time
+		msg      string
+	}{
+		{"через 3 рабочих дня", nil, ShiftNone, time.Date(2020, 10, 14, 12, 1, 0, 0, loc), ""},
+		{"через 3 рабочих дня", holidays, ShiftNone, time.Date(2020, 10, 15, 12, 1, 0, 0, loc), ""},
+		{"сдать через 10 рабочих дней", nil, ShiftNone, time.Date(2020, 10, 23, 12, 1, 0, 0, loc), "сдать"},
+		{"in 5 business days", nil, ShiftNone, time.Date(2020, 10, 16, 12, 1, 0, 0, loc), ""},
+		{"in a working day", nil, ShiftNone, time.Date(2020, 10, 12, 12, 1, 0, 0, loc), ""},
+		{"2 рабочих дня назад", nil, ShiftNone, time.Date(2020, 10, 7, 12, 1, 0, 0, loc), ""},
+		// the fractional count has no exact day, the rest of the text has
+		{"созвон завтра через полтора рабочих дня", nil, ShiftNone, time.Date(2020, 10, 10, 18, 0, 0, 0, loc), "созвон через полтора рабочих дня"},
+		{"call tomorrow in 1.5 business days", nil, ShiftNone, time.Date(2020, 10, 10, 18, 0, 0, 0, loc), "call in 1.5 business days"},
+		{"next working day", nil, ShiftNone, time.Date(2020, 10, 12, 18, 0, 0, 0, loc), ""},
+		{"следующий рабочий день", holidays, ShiftNone, time.Date(2020, 10, 13, 18, 0, 0, 0, loc), ""},
+		{"в следующий рабочий день в 10:00 созвон", nil, ShiftNone, time.Date(2020, 10, 12, 10, 0, 0, 0, loc), "созвон"},
+		{"previous business day", nil, ShiftNone, time.Date(2020, 10, 8, 18, 0, 0, 0, loc), ""},
+		{"завтра", nil, ShiftNone, time.Date(2020, 10, 10, 18, 0, 0, 0, loc), ""},
+		{"завтра", nil, ShiftForward, time.Date(2020, 10, 12, 18, 0, 0, 0, loc), ""},
+		{"завтра", holidays, ShiftForward, time.Date(2020, 10, 13, 18, 0, 0, 0, loc), ""},
+		{"завтра", nil, ShiftBackward, time.Date(2020, 10, 9, 18, 0, 0, 0, loc), ""},
+		{"в конце следующей недели", holidays, ShiftNone, time.Date(2020, 10, 16, 18, 0, 0, 0, loc), ""},
+		{"в начале следующей недели", holidays, ShiftNone, time.Date(2020, 10, 13, 9, 0, 0, 0, loc), ""},
+		{"через 1 день", nil, ShiftForward, time.Date(2020, 10, 10, 12, 1, 0, 0, loc), ""},
+		{"завтра в 10:00", nil, ShiftForward, time.Date(2020, 10, 10, 10, 0, 0, 0, loc), ""},
+		{"на выходных", nil, ShiftForward, time.Date(2020, 10, 10, 18, 0, 0, 0, loc), ""},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			date, msg := Parse(tt.input, &Opts{Now: dt, Calendar: tt.calendar, NonWorkingShift: tt.shift})
+			if !date.Equal(tt.want) || msg != tt.msg {
+				t.Errorf("got %s '%s' want %s '%s'", date, msg, tt.want, tt.msg)
+			}
+		})
+	}
+}
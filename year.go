@@ -0,0 +1,36 @@
+package dateparse
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// two-digit years below the pivot belong to this century, the rest to the previous one
+const defaultYearPivot = 69
+
+var (
+	yearRegex   = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(?:%s[" "])?([1-9]\d\d\d)[" "]?(?:году|год|г[.]?)(?:[" "]|$)`, datePrefix))
+	enYearRegex = regexp.MustCompile(`(?:^|[" "])in[" "](?:the[" "]year[" "])?((?:19|20|21)\d\d)(?:[" "]|$|[.,])`)
+)
+
+func calculateYear(m []string, opts Opts, yearPosition int) (time.Time, string) {
+	start := getDate(forceInt(m[yearPosition]), time.January, 1, 0, 0, 0, opts)
+	return anchorPeriod(start, start.AddDate(1, 0, 0), opts), m[0]
+}
+
+// "87" -> 1987, "24" -> 2024
+func fullYear(s string, opts Opts) int {
+	if len(s) != 2 {
+		return forceInt(s[:4])
+	}
+	pivot := opts.YearPivot
+	if pivot == 0 {
+		pivot = defaultYearPivot
+	}
+	year := forceInt(s)
+	if year < pivot {
+		return 2000 + year
+	}
+	return 1900 + year
+}
@@ -0,0 +1,186 @@
+package dateparse
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// an abbreviation names a fixed offset: "pst" is UTC-8 in july too, daylight saving time has its own
+// abbreviation. A city follows the daylight saving rules. Abbreviations that are english words are left out.
+var zoneAbbreviations = map[string]time.Duration{
+	"utc":  0,
+	"gmt":  0,
+	"bst":  1 * time.Hour,
+	"cet":  1 * time.Hour,
+	"cest": 2 * time.Hour,
+	"eet":  2 * time.Hour,
+	"eest": 3 * time.Hour,
+	"msk":  3 * time.Hour,
+	"мск":  3 * time.Hour,
+	"ist":  5*time.Hour + 30*time.Minute,
+	"jst":  9 * time.Hour,
+	"hst":  -10 * time.Hour,
+	"akst": -9 * time.Hour,
+	"pst":  -8 * time.Hour,
+	"pdt":  -7 * time.Hour,
+	"mst":  -7 * time.Hour,
+	"mdt":  -6 * time.Hour,
+	"cst":  -6 * time.Hour,
+	"cdt":  -5 * time.Hour,
+	"est":  -5 * time.Hour,
+	"edt":  -4 * time.Hour,
+}
+
+var zoneCities = map[string]string{
+	"москве":           "Europe/Moscow",
+	"питеру":           "Europe/Moscow",
+	"петербургу":       "Europe/Moscow",
+	"санкт-петербургу": "Europe/Moscow",
+	"калининграду":     "Europe/Kaliningrad",
+	"самаре":           "Europe/Samara",
+	"екатеринбургу":    "Asia/Yekaterinburg",
+	"омску":            "Asia/Omsk",
+	"новосибирску":     "Asia/Novosibirsk",
+	"красноярску":      "Asia/Krasnoyarsk",
+	"иркутску":         "Asia/Irkutsk",
+	"якутску":          "Asia/Yakutsk",
+	"владивостоку":     "Asia/Vladivostok",
+	"магадану":         "Asia/Magadan",
+	"минску":           "Europe/Minsk",
+	"киеву":            "Europe/Kiev",
+	"алматы":           "Asia/Almaty",
+	"ташкенту":         "Asia/Tashkent",
+	"тбилиси":          "Asia/Tbilisi",
+	"лондону":          "Europe/London",
+	"берлину":          "Europe/Berlin",
+	"парижу":           "Europe/Paris",
+	"дубаю":            "Asia/Dubai",
+	"токио":            "Asia/Tokyo",
+	"нью-йорку":        "America/New_York",
+	"moscow":           "Europe/Moscow",
+	"london":           "Europe/London",
+	"berlin":           "Europe/Berlin",
+	"paris":            "Europe/Paris",
+	"kyiv":             "Europe/Kiev",
+	"kiev":             "Europe/Kiev",
+	"dubai":            "Asia/Dubai",
+	"tokyo":            "Asia/Tokyo",
+	"new york":         "America/New_York",
+	"chicago":          "America/Chicago",
+	"denver":           "America/Denver",
+	"los angeles":      "America/Los_Angeles",
+	"pacific":          "America/Los_Angeles",
+	"eastern":          "America/New_York",
+	"central":          "America/Chicago",
+	"mountain":         "America/Denver",
+}
+
+var (
+	zoneAbbreviationWords = alternation(abbreviationKeys())
+	enZoneCities          = alternation(latinWords(cityKeys()))
+	ruZoneCities          = alternation(strings.Join(nonLatin(splitAlternation(cityKeys())), "|"))
+)
+
+var (
+	offsetZoneRegex = regexp.MustCompile(`(?:^|[" "])(?:по[" "])?(utc|gmt)([+-])(\d\d?)(?::?(\d\d))?(?:[" "]|$)`)
+	abbrZoneRegex   = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(?:по[" "])?(%s)(?:[" "]|$|[.,])`, zoneAbbreviationWords))
+	ruCityZoneRegex = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(?:по[" "])(?:времени[" "])?(%s)(?:[" "]|$|[.,])`, ruZoneCities))
+	enCityZoneRegex = regexp.MustCompile(fmt.Sprintf(`(?:^|[" "])(%s)[" "]time\b`, enZoneCities))
+	ianaZoneRegex   = regexp.MustCompile(`\b([a-z]+/[a-z_\-]+(?:/[a-z_\-]+)?)\b`)
+)
+
+// finds a time zone mentioned in the text and the span it takes
+func extractZone(s string) (string, *time.Location) {
+	switch {
+	case offsetZoneRegex.MatchString(s):
+		m := offsetZoneRegex.FindStringSubmatch(s)
+		offset := time.Duration(forceInt(m[3]))*time.Hour + time.Duration(forceInt(m[4]))*time.Minute
+		if m[2] == "-" {
+			offset = -offset
+		}
+		return matchSpan(m[0]), time.FixedZone(strings.ToUpper(strings.TrimSpace(m[0])), int(offset.Seconds()))
+	case abbrZoneRegex.MatchString(s):
+		m := abbrZoneRegex.FindStringSubmatch(s)
+		name := strings.ToUpper(m[1])
+		if name == "МСК" {
+			name = "MSK"
+		}
+		return matchSpan(m[0]), time.FixedZone(name, int(zoneAbbreviations[m[1]].Seconds()))
+	case ruCityZoneRegex.MatchString(s):
+		m := ruCityZoneRegex.FindStringSubmatch(s)
+		if loc, err := time.LoadLocation(zoneCities[m[1]]); err == nil {
+			return matchSpan(m[0]), loc
+		}
+	case enCityZoneRegex.MatchString(s):
+		m := enCityZoneRegex.FindStringSubmatch(s)
+		if loc, err := time.LoadLocation(zoneCities[m[1]]); err == nil {
+			return matchSpan(m[0]), loc
+		}
+	case ianaZoneRegex.MatchString(s):
+		m := ianaZoneRegex.FindStringSubmatch(s)
+		if loc, err := time.LoadLocation(ianaName(m[1])); err == nil {
+			return matchSpan(m[0]), loc
+		}
+	}
+	return "", nil
+}
+
+func matchSpan(match string) string {
+	return strings.TrimRight(strings.TrimSpace(match), ".,")
+}
+
+func abbreviationKeys() string {
+	keys := make([]string, 0, len(zoneAbbreviations))
+	for k := range zoneAbbreviations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, "|")
+}
+
+func cityKeys() string {
+	keys := make([]string, 0, len(zoneCities))
+	for k := range zoneCities {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, "|")
+}
+
+func nonLatin(words []string) []string {
+	var res []string
+	for _, w := range words {
+		if !latinRegex.MatchString(w) {
+			res = append(res, w)
+		}
+	}
+	return res
+}
+
+// removes the matched text together with one of the spaces around it
+func cutMatch(s string, match string) string {
+	match = strings.TrimRight(strings.TrimSpace(match), ".,")
+	i := strings.Index(s, match)
+	if i < 0 || match == "" {
+		return s
+	}
+	before, after := s[:i], s[i+len(match):]
+	if strings.HasSuffix(before, " ") && strings.HasPrefix(after, " ") {
+		after = after[1:]
+	}
+	return strings.TrimSpace(before + after)
+}
+
+// "america/new_york" -> "America/New_York"
+func ianaName(s string) string {
+	b := []byte(s)
+	for i := range b {
+		if (i == 0 || b[i-1] == '/' || b[i-1] == '_' || b[i-1] == '-') && b[i] >= 'a' && b[i] <= 'z' {
+			b[i] -= 'a' - 'A'
+		}
+	}
+	return string(b)
+}